@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/DazWilkin/vultr-exporter/collector"
+	"github.com/go-logr/logr"
+)
+
+// backfill implements the `backfill` subcommand
+// It writes the account's Invoices' items as timestamped OpenMetrics samples
+// These may be imported into Prometheus using `promtool tsdb create-blocks-from openmetrics`
+func backfill(args []string, log logr.Logger) int {
+	log = log.WithName("backfill")
+
+	fs := flag.NewFlagSet("backfill", flag.ExitOnError)
+	months := fs.Int("months", 12, "The number of months of Invoices to backfill")
+	output := fs.String("output", "", "The file to which OpenMetrics samples are written (default: stdout)")
+	if err := fs.Parse(args); err != nil {
+		log.Error(err, "Unable to parse flags")
+		return 1
+	}
+
+	var key string
+	if key = os.Getenv("API_KEY"); key == "" {
+		log.Info("Expected `API_KEY` in the environment")
+		return 1
+	}
+
+	client := NewVultrClient(name, key)
+
+	s := collector.System{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Version:   version,
+	}
+
+	var f *os.File
+	w := os.Stdout
+	if *output != "" {
+		var err error
+		f, err = os.Create(*output)
+		if err != nil {
+			log.Error(err, "Unable to create output file",
+				"output", *output,
+			)
+			return 1
+		}
+		w = f
+	}
+
+	since := time.Now().AddDate(0, -*months, 0)
+	err := collector.Backfill(context.Background(), w, s, client, since, log)
+
+	// The output file is closed explicitly because samples may be lost if closing fails
+	if f != nil {
+		if err := f.Close(); err != nil {
+			log.Error(err, "Unable to close output file",
+				"output", *output,
+			)
+			return 1
+		}
+	}
+
+	if err != nil {
+		log.Error(err, "Unable to backfill")
+		return 1
+	}
+
+	log.Info("Backfilled",
+		"months", *months,
+		"since", since.Format(time.DateOnly),
+	)
+	return 0
+}
@@ -65,6 +65,12 @@ var (
 var (
 	endpoint    = flag.String("endpoint", "0.0.0.0:8080", "The endpoint of the HTTP server")
 	metricsPath = flag.String("path", "/metrics", "The path on which Prometheus metrics will be served")
+
+	dnsCheckDangling     = flag.Bool("dns_check_dangling", false, "Report DNS A/AAAA records whose addresses are not owned by the account")
+	loadBalancerCheckSSL = flag.Bool("load_balancer_check_ssl", false, "Report the expiry of SSL certificates retrieved from Load Balancers' HTTPS frontends")
+	billingAllocation    = flag.Bool("billing_allocation", false, "Allocate pending charges to the Instances, Load Balancers, Block Storage and Kubernetes clusters that incurred them")
+	billingHistoryMonths = flag.Int("billing_history_months", 3, "The number of months of Invoices and billing history to report")
+	budgets              = flag.String("budgets", "", "The path to a JSON file of monthly budgets (USD) for the account and for products")
 )
 var (
 	name string = fmt.Sprintf("%s_%s", namespace, subsystem)
@@ -111,12 +117,24 @@ func main() {
 	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
 	log = log.WithName("main")
 
+	// The `backfill` subcommand writes historical billing data rather than serving metrics
+	if len(os.Args) > 1 && os.Args[1] == "backfill" {
+		os.Exit(backfill(os.Args[2:], log))
+	}
+
 	flag.Parse()
 	if *endpoint == "" {
 		log.Info("Expected flag `--endpoint`")
 		os.Exit(1)
 	}
 
+	if *billingHistoryMonths <= 0 {
+		log.Info("Expected flag `--billing_history_months` to be greater than 0",
+			"billing_history_months", *billingHistoryMonths,
+		)
+		os.Exit(1)
+	}
+
 	var key string
 	if key = os.Getenv("API_KEY"); key == "" {
 		log.Info("Expected `API_KEY` in the environment")
@@ -149,11 +167,43 @@ func main() {
 	}
 	registry.MustRegister(collector.NewExporterCollector(s, b, log))
 	registry.MustRegister(collector.NewAccountCollector(s, client, log))
+	registry.MustRegister(collector.NewBackupCollector(s, client, log))
+	registry.MustRegister(collector.NewBackupScheduleCollector(s, client, log))
+	registry.MustRegister(collector.NewBareMetalCollector(s, client, log))
 	registry.MustRegister(collector.NewBillingCollector(s, client, log))
+	if *billingAllocation {
+		registry.MustRegister(collector.NewBillingAllocationCollector(s, client, log))
+	}
+	registry.MustRegister(collector.NewBillingHistoryCollector(s, client, *billingHistoryMonths, log))
 	registry.MustRegister(collector.NewBlockStorageCollector(s, client, log))
+	if *budgets != "" {
+		budget, err := collector.ReadBudget(*budgets)
+		if err != nil {
+			log.Error(err, "Unable to read budgets",
+				"budgets", *budgets,
+			)
+			os.Exit(1)
+		}
+		registry.MustRegister(collector.NewBudgetCollector(s, client, budget, log))
+	}
+	registry.MustRegister(collector.NewCDNCollector(s, client, log))
+	registry.MustRegister(collector.NewContainerRegistryCollector(s, client, log))
+	registry.MustRegister(collector.NewDNSCollector(s, client, *dnsCheckDangling, log))
+	registry.MustRegister(collector.NewFirewallCollector(s, client, log))
+	registry.MustRegister(collector.NewIdleResourceCollector(s, client, log))
+	registry.MustRegister(collector.NewInstanceCollector(s, client, log))
 	registry.MustRegister(collector.NewKubernetesCollector(s, client, log))
-	registry.MustRegister(collector.NewLoadBalancerCollector(s, client, log))
+	registry.MustRegister(collector.NewManagedDatabaseCollector(s, client, log))
+	registry.MustRegister(collector.NewLoadBalancerCollector(s, client, *loadBalancerCheckSSL, log))
+	registry.MustRegister(collector.NewObjectStorageCollector(s, client, log))
+	registry.MustRegister(collector.NewPlanCollector(s, client, log))
+	registry.MustRegister(collector.NewRegionCollector(s, client, log))
 	registry.MustRegister(collector.NewReservedIPsCollector(s, client, log))
+	registry.MustRegister(collector.NewServerlessInferenceCollector(s, client, log))
+	registry.MustRegister(collector.NewSnapshotCollector(s, client, log))
+	registry.MustRegister(collector.NewVirtualFileSystemCollector(s, client, log))
+	registry.MustRegister(collector.NewVPCCollector(s, client, log))
+	registry.MustRegister(collector.NewVPC2Collector(s, client, log))
 
 	mux := http.NewServeMux()
 	mux.Handle("/", handleRoot(log))
@@ -0,0 +1,195 @@
+package collector
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/vultr/govultr/v3"
+)
+
+// invoiceCollector collects the items of a single Invoice
+// It uses InvoiceItemCollectors so that the metrics match those of BillingCollector
+type invoiceCollector struct {
+	System System
+	Client *govultr.Client
+	Log    logr.Logger
+	Items  []govultr.InvoiceItem
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *invoiceCollector) Collect(ch chan<- prometheus.Metric) {
+	// Group invoice items by product+description to ensure proper aggregation
+	collectors := make(map[string]*InvoiceItemCollector)
+	for i := range c.Items {
+		item := &c.Items[i]
+		key := getCollectorKey(item.Product, item.Description)
+
+		collector, exists := collectors[key]
+		if !exists {
+			collector = NewInvoiceItemCollector(System{
+				Namespace: c.System.Namespace,
+				Subsystem: "billing",
+				Version:   c.System.Version,
+			}, c.Client, c.Log)
+			collectors[key] = collector
+		}
+		collector.Aggregate(item)
+	}
+
+	for _, collector := range collectors {
+		collector.EmitMetrics(ch)
+	}
+}
+
+// Describe implements Prometheus' Collector interface
+// It describes no metrics because the metrics depend upon the Invoice's items
+func (c *invoiceCollector) Describe(ch chan<- *prometheus.Desc) {}
+
+// Backfill writes the items of Invoices dated after since to w in OpenMetrics format
+// Each sample is timestamped with the date of its Invoice
+// The metrics (billing_cost_usd, billing_units) are the same as those of BillingCollector
+// The output is intended to be used with `promtool tsdb create-blocks-from openmetrics`
+func Backfill(ctx context.Context, w io.Writer, s System, client *govultr.Client, since time.Time, log logr.Logger) error {
+	log = log.WithName("Backfill")
+
+	// Get all invoices across all pages
+	var allInvoices []govultr.Invoice
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		invoices, meta, _, err := client.Billing.ListInvoices(ctx, options)
+		if err != nil {
+			return err
+		}
+
+		allInvoices = append(allInvoices, invoices...)
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	// Samples must be written in timestamp order
+	dates := make(map[int]time.Time)
+	var invoices []govultr.Invoice
+	for _, invoice := range allInvoices {
+		date, err := parseTime(invoice.Date)
+		if err != nil {
+			log.Error(err, "Unable to parse Invoice date",
+				"id", invoice.ID,
+			)
+			continue
+		}
+		if date.Before(since) {
+			continue
+		}
+
+		dates[invoice.ID] = date
+		invoices = append(invoices, invoice)
+	}
+	sort.SliceStable(invoices, func(i, j int) bool {
+		return dates[invoices[i].ID].Before(dates[invoices[j].ID])
+	})
+
+	// Metric Families are merged across Invoices
+	// Each Metric Family must only be written once
+	var names []string
+	families := make(map[string]*dto.MetricFamily)
+
+	for _, invoice := range invoices {
+		var allItems []govultr.InvoiceItem
+		options := &govultr.ListOptions{
+			PerPage: 500,
+		}
+
+		for {
+			items, meta, _, err := client.Billing.ListInvoiceItems(ctx, invoice.ID, options)
+			if err != nil {
+				return err
+			}
+
+			allItems = append(allItems, items...)
+
+			// If we've received all items or there's no next page, break
+			if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+				break
+			}
+
+			// Move to next page
+			options.Cursor = meta.Links.Next
+		}
+
+		registry := prometheus.NewRegistry()
+		if err := registry.Register(&invoiceCollector{
+			System: s,
+			Client: client,
+			Log:    log,
+			Items:  allItems,
+		}); err != nil {
+			return err
+		}
+
+		mfs, err := registry.Gather()
+		if err != nil {
+			return err
+		}
+
+		timestamp := dates[invoice.ID].UnixMilli()
+		for _, mf := range mfs {
+			for _, m := range mf.Metric {
+				m.TimestampMs = &timestamp
+			}
+
+			family, exists := families[mf.GetName()]
+			if !exists {
+				names = append(names, mf.GetName())
+				families[mf.GetName()] = mf
+				continue
+			}
+			family.Metric = append(family.Metric, mf.Metric...)
+		}
+	}
+
+	sort.Strings(names)
+	for _, name := range names {
+		family := families[name]
+
+		// Samples of the same series must be adjacent
+		// The sort is stable so each series' samples remain in timestamp order
+		sort.SliceStable(family.Metric, func(i, j int) bool {
+			return labelsKey(family.Metric[i]) < labelsKey(family.Metric[j])
+		})
+
+		if _, err := expfmt.MetricFamilyToOpenMetrics(w, family); err != nil {
+			return err
+		}
+	}
+
+	_, err := expfmt.FinalizeOpenMetrics(w)
+	return err
+}
+
+// labelsKey returns a key that uniquely identifies a Metric's labels
+func labelsKey(m *dto.Metric) string {
+	var b strings.Builder
+	for _, label := range m.GetLabel() {
+		b.WriteString(label.GetName())
+		b.WriteString("=")
+		b.WriteString(label.GetValue())
+		b.WriteString(",")
+	}
+	return b.String()
+}
@@ -0,0 +1,144 @@
+package collector
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-logr/stdr"
+)
+
+var (
+	responseBackfillInvoices string = `{
+		"billing_invoices": [
+			{
+				"id": 2,
+				"date": "2024-02-01T00:00:00+00:00",
+				"description": "Invoice #2",
+				"amount": 10,
+				"balance": 0
+			},
+			{
+				"id": 1,
+				"date": "2024-01-01T00:00:00+00:00",
+				"description": "Invoice #1",
+				"amount": 15,
+				"balance": 0
+			},
+			{
+				"id": 0,
+				"date": "2023-01-01T00:00:00+00:00",
+				"description": "Too old",
+				"amount": 100,
+				"balance": 0
+			}
+		],
+		"meta": {
+			"total": 3,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseBackfillInvoiceItems1 string = `{
+		"invoice_items": [
+			{
+				"description": "Load Balancer (my-loadbalancer)",
+				"product": "Load Balancer",
+				"units": 720,
+				"unit_type": "hours",
+				"unit_price": 0.015,
+				"total": 10
+			},
+			{
+				"description": "Cloud Compute (my-instance)",
+				"product": "Vultr Cloud Compute",
+				"units": 720,
+				"unit_type": "hours",
+				"unit_price": 0.007,
+				"total": 5
+			}
+		],
+		"meta": {
+			"total": 2,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseBackfillInvoiceItems2 string = `{
+		"invoice_items": [
+			{
+				"description": "Load Balancer (my-loadbalancer)",
+				"product": "Load Balancer",
+				"units": 672,
+				"unit_type": "hours",
+				"unit_price": 0.015,
+				"total": 10
+			}
+		],
+		"meta": {
+			"total": 1,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	openMetricsBackfill string = `# HELP test_billing_cost_usd Total cost in USD
+# TYPE test_billing_cost_usd gauge
+test_billing_cost_usd{description="Cloud Compute (my-instance)",product="Vultr Cloud Compute"} 5.0 1.7040672e+09
+test_billing_cost_usd{description="Load Balancer (my-loadbalancer)",product="Load Balancer"} 10.0 1.7040672e+09
+test_billing_cost_usd{description="Load Balancer (my-loadbalancer)",product="Load Balancer"} 10.0 1.7067456e+09
+# HELP test_billing_units Number of units consumed
+# TYPE test_billing_units gauge
+test_billing_units{description="Cloud Compute (my-instance)",product="Vultr Cloud Compute",unit_price="0.007000",unit_type="hours"} 720.0 1.7040672e+09
+test_billing_units{description="Load Balancer (my-loadbalancer)",product="Load Balancer",unit_price="0.015000",unit_type="hours"} 720.0 1.7040672e+09
+test_billing_units{description="Load Balancer (my-loadbalancer)",product="Load Balancer",unit_price="0.015000",unit_type="hours"} 672.0 1.7067456e+09
+# EOF
+`
+)
+
+func TestBackfill(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for path, response := range map[string]string{
+		"/v2/billing/invoices":         responseBackfillInvoices,
+		"/v2/billing/invoices/1/items": responseBackfillInvoiceItems1,
+		"/v2/billing/invoices/2/items": responseBackfillInvoiceItems2,
+	} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if _, err := fmt.Fprint(w, response); err != nil {
+				t.Errorf("unable to write response: %v", err)
+			}
+		})
+	}
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	since := time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC)
+
+	var got bytes.Buffer
+	if err := Backfill(context.Background(), &got, s, client, since, log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.String() != openMetricsBackfill {
+		t.Errorf("got:\n%s\nwant:\n%s", got.String(), openMetricsBackfill)
+	}
+}
@@ -0,0 +1,352 @@
+package collector
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+const (
+	// backupConcurrency is the maximum number of concurrent queries for Instances' Backups and Backup schedules
+	backupConcurrency = 10
+	// backupInstancesTTL is the duration for which the list of Instances is shared by the Backup collectors
+	backupInstancesTTL = 10 * time.Second
+)
+
+// backupSemaphore bounds the concurrent per-Instance queries of BackupCollector and BackupScheduleCollector
+// It is shared so that the bound applies to both collectors together
+var backupSemaphore = make(chan struct{}, backupConcurrency)
+
+// backupInstances caches the list of Instances (by Client) so that it is shared by the Backup collectors
+// Both collectors are collected by the same scrape and would otherwise each list the Instances
+var backupInstances = struct {
+	mu      sync.Mutex
+	entries map[*govultr.Client]backupInstancesEntry
+}{
+	entries: make(map[*govultr.Client]backupInstancesEntry),
+}
+
+// backupInstancesEntry is a list of Instances and the time at which it was listed
+type backupInstancesEntry struct {
+	Instances []govultr.Instance
+	Listed    time.Time
+}
+
+// listBackupInstances returns all Instances across all pages
+// A list retrieved within backupInstancesTTL is reused
+// Concurrent callers wait for (and share) a single list
+func listBackupInstances(ctx context.Context, client *govultr.Client) ([]govultr.Instance, error) {
+	backupInstances.mu.Lock()
+	defer backupInstances.mu.Unlock()
+
+	if entry, ok := backupInstances.entries[client]; ok && time.Since(entry.Listed) < backupInstancesTTL {
+		return entry.Instances, nil
+	}
+
+	var allInstances []govultr.Instance
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		instances, meta, _, err := client.Instance.List(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		allInstances = append(allInstances, instances...)
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	backupInstances.entries[client] = backupInstancesEntry{
+		Instances: allInstances,
+		Listed:    time.Now(),
+	}
+	return allInstances, nil
+}
+
+// backupsBase represents a page of Backups
+// govultr's Backup.List does not support filtering by Instance
+type backupsBase struct {
+	Backups []govultr.Backup `json:"backups"`
+	Meta    *govultr.Meta    `json:"meta"`
+}
+
+// BackupCollector represents Backups
+type BackupCollector struct {
+	System  System
+	Client  *govultr.Client
+	Log     logr.Logger
+	Up      *prometheus.Desc
+	Size    *prometheus.Desc
+	Created *prometheus.Desc
+	Latest  *prometheus.Desc
+}
+
+// NewBackupCollector creates a new BackupCollector
+func NewBackupCollector(s System, client *govultr.Client, log logr.Logger) *BackupCollector {
+	subsystem := "backup"
+	labelKeys := []string{
+		"id",
+		"description",
+		"status",
+	}
+	return &BackupCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Up: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "up"),
+			"Backup",
+			labelKeys,
+			nil,
+		),
+		Size: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "size_bytes"),
+			"Size (bytes) of Backup",
+			labelKeys,
+			nil,
+		),
+		Created: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "created_time"),
+			"Backup creation time in Unix epoch seconds",
+			labelKeys,
+			nil,
+		),
+		Latest: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "latest_time"),
+			"Creation time of the Instance's most recent complete Backup in Unix epoch seconds (0 if none)",
+			[]string{
+				"instance_id",
+				"label",
+				"region",
+				"tags",
+			},
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *BackupCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		c.collectBackups(ctx, ch)
+	}()
+	go func() {
+		defer wg.Done()
+		c.collectLatest(ctx, ch)
+	}()
+	wg.Wait()
+}
+
+// collectBackups collects the account's Backups
+func (c *BackupCollector) collectBackups(ctx context.Context, ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("collectBackups")
+
+	// Get all backups across all pages
+	var allBackups []govultr.Backup
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		backups, meta, _, err := c.Client.Backup.List(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to Backup.List")
+			return
+		}
+
+		allBackups = append(allBackups, backups...)
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	// Enumerate the backups
+	var wg sync.WaitGroup
+	for _, backup := range allBackups {
+		wg.Add(1)
+		go func(backup govultr.Backup) {
+			defer wg.Done()
+
+			labelValues := []string{
+				backup.ID,
+				backup.Description,
+				backup.Status,
+			}
+
+			ch <- prometheus.MustNewConstMetric(
+				c.Up,
+				prometheus.CounterValue,
+				func(status string) (result float64) {
+					if status == "complete" {
+						result = 1.0
+					}
+					return result
+				}(backup.Status),
+				labelValues...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.Size,
+				prometheus.GaugeValue,
+				float64(backup.Size),
+				labelValues...,
+			)
+
+			created, err := parseTime(backup.DateCreated)
+			if err != nil {
+				log.Error(err, "Unable to parse Backup creation time",
+					"id", backup.ID,
+				)
+				return
+			}
+			ch <- prometheus.MustNewConstMetric(
+				c.Created,
+				prometheus.GaugeValue,
+				float64(created.Unix()),
+				labelValues...,
+			)
+		}(backup)
+	}
+	wg.Wait()
+}
+
+// collectLatest collects the time of each Instance's most recent complete Backup
+func (c *BackupCollector) collectLatest(ctx context.Context, ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("collectLatest")
+
+	// Get all instances across all pages
+	allInstances, err := listBackupInstances(ctx, c.Client)
+	if err != nil {
+		log.Error(err, "Unable to Instance.List")
+		return
+	}
+
+	// Enumerate the instances' backups
+	// The number of concurrent queries is bounded to avoid Vultr's API rate limit
+	var wg sync.WaitGroup
+	for _, instance := range allInstances {
+		wg.Add(1)
+		go func(instance govultr.Instance) {
+			defer wg.Done()
+
+			backupSemaphore <- struct{}{}
+			defer func() { <-backupSemaphore }()
+
+			backups, err := c.listInstanceBackups(ctx, instance.ID)
+			if err != nil {
+				log.Error(err, "Unable to list Instance's Backups",
+					"id", instance.ID,
+				)
+				return
+			}
+
+			// Instances without a complete Backup are reported as 0
+			var latest time.Time
+			for _, backup := range backups {
+				if backup.Status != "complete" {
+					continue
+				}
+
+				created, err := parseTime(backup.DateCreated)
+				if err != nil {
+					log.Error(err, "Unable to parse Backup creation time",
+						"id", backup.ID,
+					)
+					continue
+				}
+				if created.After(latest) {
+					latest = created
+				}
+			}
+
+			var value float64
+			if !latest.IsZero() {
+				value = float64(latest.Unix())
+			}
+			ch <- prometheus.MustNewConstMetric(
+				c.Latest,
+				prometheus.GaugeValue,
+				value,
+				[]string{
+					instance.ID,
+					instance.Label,
+					instance.Region,
+					strings.Join(instance.Tags, ","),
+				}...,
+			)
+		}(instance)
+	}
+	wg.Wait()
+}
+
+// listInstanceBackups returns the Backups of the Instance
+// The Vultr API filters Backups by the instance_id query parameter but govultr's ListOptions does not include it
+func (c *BackupCollector) listInstanceBackups(ctx context.Context, instanceID string) ([]govultr.Backup, error) {
+	var allBackups []govultr.Backup
+	cursor := ""
+
+	for {
+		req, err := c.Client.NewRequest(ctx, http.MethodGet, "/v2/backups", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		query := url.Values{}
+		query.Set("instance_id", instanceID)
+		query.Set("per_page", "100")
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		}
+		req.URL.RawQuery = query.Encode()
+
+		page := &backupsBase{}
+		if _, err := c.Client.DoWithContext(ctx, req, page); err != nil {
+			return nil, err
+		}
+
+		allBackups = append(allBackups, page.Backups...)
+
+		// If we've received all items or there's no next page, break
+		if page.Meta == nil || page.Meta.Links == nil || page.Meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		cursor = page.Meta.Links.Next
+	}
+
+	return allBackups, nil
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *BackupCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Up
+	ch <- c.Size
+	ch <- c.Created
+	ch <- c.Latest
+}
@@ -0,0 +1,131 @@
+package collector
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+// BackupScheduleCollector represents the (automatic) Backup schedules of Instances
+type BackupScheduleCollector struct {
+	System  System
+	Client  *govultr.Client
+	Log     logr.Logger
+	Enabled *prometheus.Desc
+	Next    *prometheus.Desc
+}
+
+// NewBackupScheduleCollector creates a new BackupScheduleCollector
+func NewBackupScheduleCollector(s System, client *govultr.Client, log logr.Logger) *BackupScheduleCollector {
+	subsystem := "backup_schedule"
+	labelKeys := []string{
+		"instance_id",
+		"label",
+		"region",
+		"tags",
+		"type",
+	}
+	return &BackupScheduleCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Enabled: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "enabled"),
+			"Whether automatic backups are enabled for the Instance (1=enabled)",
+			labelKeys,
+			nil,
+		),
+		Next: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "next_time"),
+			"Next scheduled backup time in Unix epoch seconds",
+			labelKeys,
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *BackupScheduleCollector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx := context.Background()
+
+	// Get all instances across all pages
+	// The list is shared with BackupCollector
+	allInstances, err := listBackupInstances(ctx, c.Client)
+	if err != nil {
+		log.Error(err, "Unable to Instance.List")
+		return
+	}
+
+	// Enumerate the instances' backup schedules
+	// The number of concurrent queries is bounded (together with BackupCollector's) to avoid Vultr's API rate limit
+	var wg sync.WaitGroup
+	for _, instance := range allInstances {
+		wg.Add(1)
+		go func(instance govultr.Instance) {
+			defer wg.Done()
+
+			backupSemaphore <- struct{}{}
+			defer func() { <-backupSemaphore }()
+
+			schedule, _, err := c.Client.Instance.GetBackupSchedule(ctx, instance.ID)
+			if err != nil {
+				log.Error(err, "Unable to Instance.GetBackupSchedule",
+					"id", instance.ID,
+				)
+				return
+			}
+
+			labelValues := []string{
+				instance.ID,
+				instance.Label,
+				instance.Region,
+				strings.Join(instance.Tags, ","),
+				schedule.Type,
+			}
+
+			enabled := schedule.Enabled != nil && *schedule.Enabled
+			ch <- prometheus.MustNewConstMetric(
+				c.Enabled,
+				prometheus.GaugeValue,
+				func(enabled bool) (result float64) {
+					if enabled {
+						result = 1.0
+					}
+					return result
+				}(enabled),
+				labelValues...,
+			)
+
+			// The next scheduled time is only meaningful when backups are enabled
+			if !enabled || schedule.NextScheduleTimeUTC == "" {
+				return
+			}
+
+			next, err := parseTime(schedule.NextScheduleTimeUTC)
+			if err != nil {
+				log.Error(err, "Unable to parse next scheduled backup time",
+					"id", instance.ID,
+				)
+				return
+			}
+			ch <- prometheus.MustNewConstMetric(
+				c.Next,
+				prometheus.GaugeValue,
+				float64(next.Unix()),
+				labelValues...,
+			)
+		}(instance)
+	}
+	wg.Wait()
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *BackupScheduleCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Enabled
+	ch <- c.Next
+}
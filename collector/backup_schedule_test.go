@@ -0,0 +1,96 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	responseBackupScheduleInstances string = `{
+		"instances": [
+			{
+				"id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+				"region": "ewr",
+				"label": "my-instance",
+				"tags": ["prod", "web"]
+			},
+			{
+				"id": "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
+				"region": "ewr",
+				"label": "my-other-instance",
+				"tags": []
+			}
+		],
+		"meta": {
+			"total": 2,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseBackupScheduleEnabled string = `{
+		"backup_schedule": {
+			"enabled": true,
+			"type": "daily",
+			"next_scheduled_time_utc": "2024-01-10 08:00:00",
+			"hour": 8
+		}
+	}`
+	responseBackupScheduleDisabled string = `{
+		"backup_schedule": {
+			"enabled": false
+		}
+	}`
+	prometheusBackupSchedule string = `
+	# HELP test_backup_schedule_enabled Whether automatic backups are enabled for the Instance (1=enabled)
+	# TYPE test_backup_schedule_enabled gauge
+	test_backup_schedule_enabled{instance_id="14b3e7d6-ffb5-4994-8502-57fcd9db3b33",label="my-other-instance",region="ewr",tags="",type=""} 0
+	test_backup_schedule_enabled{instance_id="cb676a46-66fd-4dfb-b839-443f2e6c0b60",label="my-instance",region="ewr",tags="prod,web",type="daily"} 1
+	# HELP test_backup_schedule_next_time Next scheduled backup time in Unix epoch seconds
+	# TYPE test_backup_schedule_next_time gauge
+	test_backup_schedule_next_time{instance_id="cb676a46-66fd-4dfb-b839-443f2e6c0b60",label="my-instance",region="ewr",tags="prod,web",type="daily"} 1.7048736e+09
+	`
+)
+
+func TestBackupScheduleCollector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for path, response := range map[string]string{
+		"/v2/instances": responseBackupScheduleInstances,
+		"/v2/instances/cb676a46-66fd-4dfb-b839-443f2e6c0b60/backup-schedule": responseBackupScheduleEnabled,
+		"/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/backup-schedule": responseBackupScheduleDisabled,
+	} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if _, err := fmt.Fprint(w, response); err != nil {
+				t.Errorf("unable to write response: %v", err)
+			}
+		})
+	}
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	collector := NewBackupScheduleCollector(s, client, log)
+
+	if err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(prometheusBackupSchedule),
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
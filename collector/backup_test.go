@@ -0,0 +1,214 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	responseBackupInstances string = `{
+		"instances": [
+			{
+				"id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+				"region": "ewr",
+				"label": "my-instance",
+				"tags": ["prod", "web"]
+			},
+			{
+				"id": "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
+				"region": "ewr",
+				"label": "my-other-instance",
+				"tags": []
+			}
+		],
+		"meta": {
+			"total": 2,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseBackups string = `{
+		"backups": [
+			{
+				"id": "b1f3a2c4-5d6e-4f7a-8b9c-0d1e2f3a4b5c",
+				"date_created": "2024-01-09T08:00:00+00:00",
+				"description": "my-instance",
+				"size": 1073741824,
+				"status": "complete"
+			}
+		],
+		"meta": {
+			"total": 1,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	// The Instance's Backups span two pages
+	// The most recent Backup is pending and is ignored
+	responseInstanceBackupsPage1 string = `{
+		"backups": [
+			{
+				"id": "b1f3a2c4-5d6e-4f7a-8b9c-0d1e2f3a4b5c",
+				"date_created": "2024-01-09T08:00:00+00:00",
+				"description": "my-instance",
+				"size": 1073741824,
+				"status": "complete"
+			}
+		],
+		"meta": {
+			"total": 3,
+			"links": {
+				"next": "page2",
+				"prev": ""
+			}
+		}
+	}`
+	responseInstanceBackupsPage2 string = `{
+		"backups": [
+			{
+				"id": "c2a4b3d5-6e7f-4a8b-9c0d-1e2f3a4b5c6d",
+				"date_created": "2024-01-08T08:00:00+00:00",
+				"description": "my-instance",
+				"size": 1073741824,
+				"status": "complete"
+			},
+			{
+				"id": "d3b5c4e6-7f8a-4b9c-8d1e-2f3a4b5c6d7e",
+				"date_created": "2024-01-10T08:00:00+00:00",
+				"description": "my-instance",
+				"size": 0,
+				"status": "pending"
+			}
+		],
+		"meta": {
+			"total": 3,
+			"links": {
+				"next": "",
+				"prev": "page1"
+			}
+		}
+	}`
+	responseInstanceBackupsNone string = `{
+		"backups": [],
+		"meta": {
+			"total": 0,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	prometheusBackupLatest string = `
+	# HELP test_backup_latest_time Creation time of the Instance's most recent complete Backup in Unix epoch seconds (0 if none)
+	# TYPE test_backup_latest_time gauge
+	test_backup_latest_time{instance_id="14b3e7d6-ffb5-4994-8502-57fcd9db3b33",label="my-other-instance",region="ewr",tags=""} 0
+	test_backup_latest_time{instance_id="cb676a46-66fd-4dfb-b839-443f2e6c0b60",label="my-instance",region="ewr",tags="prod,web"} 1.7047872e+09
+	`
+)
+
+func TestBackupCollector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := fmt.Fprint(w, responseBackupInstances); err != nil {
+			t.Errorf("unable to write response: %v", err)
+		}
+	})
+	mux.HandleFunc("/v2/backups", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		response := responseBackups
+		switch query.Get("instance_id") {
+		case "":
+		case "cb676a46-66fd-4dfb-b839-443f2e6c0b60":
+			response = responseInstanceBackupsPage1
+			if query.Get("cursor") == "page2" {
+				response = responseInstanceBackupsPage2
+			}
+		default:
+			response = responseInstanceBackupsNone
+		}
+
+		if _, err := fmt.Fprint(w, response); err != nil {
+			t.Errorf("unable to write response: %v", err)
+		}
+	})
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	collector := NewBackupCollector(s, client, log)
+
+	if err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(prometheusBackupLatest),
+		"test_backup_latest_time",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
+
+func TestBackupCollectorsShareInstances(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls atomic.Int32
+	mux.HandleFunc("/v2/instances", func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if _, err := fmt.Fprint(w, responseBackupInstances); err != nil {
+			t.Errorf("unable to write response: %v", err)
+		}
+	})
+	for path, response := range map[string]string{
+		"/v2/backups": responseInstanceBackupsNone,
+		"/v2/instances/cb676a46-66fd-4dfb-b839-443f2e6c0b60/backup-schedule": responseBackupScheduleEnabled,
+		"/v2/instances/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/backup-schedule": responseBackupScheduleDisabled,
+	} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if _, err := fmt.Fprint(w, response); err != nil {
+				t.Errorf("unable to write response: %v", err)
+			}
+		})
+	}
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(NewBackupCollector(s, client, log))
+	registry.MustRegister(NewBackupScheduleCollector(s, client, log))
+
+	if _, err := registry.Gather(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("got %d Instance.List requests, want 1", got)
+	}
+}
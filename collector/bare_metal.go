@@ -0,0 +1,271 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+// BareMetalCollector represents Bare Metal servers
+type BareMetalCollector struct {
+	System    System
+	Client    *govultr.Client
+	Log       logr.Logger
+	Up        *prometheus.Desc
+	CPUs      *prometheus.Desc
+	RAM       *prometheus.Desc
+	Disk      *prometheus.Desc
+	Bandwidth *prometheus.Desc
+}
+
+// NewBareMetalCollector creates a new BareMetalCollector
+func NewBareMetalCollector(s System, client *govultr.Client, log logr.Logger) *BareMetalCollector {
+	subsystem := "bare_metal"
+	return &BareMetalCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Up: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "up"),
+			"Bare Metal server",
+			[]string{
+				"id",
+				"label",
+				"region",
+				"plan",
+				"os",
+				"status",
+			},
+			nil,
+		),
+		CPUs: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "cpu_count"),
+			"Number of CPUs",
+			[]string{
+				"id",
+				"label",
+				"region",
+				"plan",
+			},
+			nil,
+		),
+		RAM: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "ram"),
+			"Size (MB) of RAM",
+			[]string{
+				"id",
+				"label",
+				"region",
+				"plan",
+			},
+			nil,
+		),
+		Disk: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "disk_info"),
+			"A metric with a constant '1' value labeled by the server's disk configuration",
+			[]string{
+				"id",
+				"label",
+				"region",
+				"plan",
+				"disk",
+			},
+			nil,
+		),
+		Bandwidth: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "bandwidth_bytes"),
+			"Bandwidth (bytes) used in the current month",
+			[]string{
+				"id",
+				"label",
+				"region",
+				"plan",
+				"direction",
+			},
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *BareMetalCollector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx := context.Background()
+
+	// Get all Bare Metal servers across all pages
+	var allServers []govultr.BareMetalServer
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		servers, meta, _, err := c.Client.BareMetalServer.List(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to BareMetalServer.List")
+			return
+		}
+
+		allServers = append(allServers, servers...)
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	// Bandwidth is reported daily and keyed by date (e.g. "2006-01-02")
+	// Only days in the current month are summed
+	month := time.Now().UTC().Format("2006-01")
+
+	// Enumerate all of the servers
+	var wg sync.WaitGroup
+	for _, server := range allServers {
+		wg.Add(1)
+		go func(server govultr.BareMetalServer) {
+			defer wg.Done()
+
+			ch <- prometheus.MustNewConstMetric(
+				c.Up,
+				prometheus.CounterValue,
+				func(status string) (result float64) {
+					if status == "active" {
+						result = 1.0
+					}
+					return result
+				}(server.Status),
+				[]string{
+					server.ID,
+					server.Label,
+					server.Region,
+					server.Plan,
+					server.Os,
+					server.Status,
+				}...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.CPUs,
+				prometheus.GaugeValue,
+				float64(server.CPUCount),
+				[]string{
+					server.ID,
+					server.Label,
+					server.Region,
+					server.Plan,
+				}...,
+			)
+			// RAM is a string e.g. "65536 MB"
+			if ram, err := parseMegabytes(server.RAM); err != nil {
+				log.Info("Unable to parse RAM",
+					"id", server.ID,
+					"ram", server.RAM,
+				)
+			} else {
+				ch <- prometheus.MustNewConstMetric(
+					c.RAM,
+					prometheus.GaugeValue,
+					ram,
+					[]string{
+						server.ID,
+						server.Label,
+						server.Region,
+						server.Plan,
+					}...,
+				)
+			}
+			// Disk is a free-form string e.g. "2x 240 GB SSD"
+			ch <- prometheus.MustNewConstMetric(
+				c.Disk,
+				prometheus.GaugeValue,
+				1.0,
+				[]string{
+					server.ID,
+					server.Label,
+					server.Region,
+					server.Plan,
+					server.Disk,
+				}...,
+			)
+
+			bandwidth, _, err := c.Client.BareMetalServer.GetBandwidth(ctx, server.ID)
+			if err != nil {
+				log.Error(err, "Unable to BareMetalServer.GetBandwidth",
+					"id", server.ID,
+				)
+				return
+			}
+
+			var incoming, outgoing int
+			for date, usage := range bandwidth.Bandwidth {
+				if strings.HasPrefix(date, month) {
+					incoming += usage.IncomingBytes
+					outgoing += usage.OutgoingBytes
+				}
+			}
+
+			for direction, value := range map[string]int{
+				"incoming": incoming,
+				"outgoing": outgoing,
+			} {
+				ch <- prometheus.MustNewConstMetric(
+					c.Bandwidth,
+					prometheus.GaugeValue,
+					float64(value),
+					[]string{
+						server.ID,
+						server.Label,
+						server.Region,
+						server.Plan,
+						direction,
+					}...,
+				)
+			}
+		}(server)
+	}
+	wg.Wait()
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *BareMetalCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Up
+	ch <- c.CPUs
+	ch <- c.RAM
+	ch <- c.Disk
+	ch <- c.Bandwidth
+}
+
+// megabytes is the number of megabytes in each unit of size
+var megabytes = map[string]float64{
+	"MB": 1,
+	"GB": 1024,
+	"TB": 1024 * 1024,
+}
+
+// parseMegabytes parses a size with units (e.g. "65536 MB", "1.5 TB") as a number of megabytes
+// Sizes without (or with other) units are rejected
+func parseMegabytes(s string) (float64, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+
+	scale, ok := megabytes[strings.ToUpper(fields[1])]
+	if !ok {
+		return 0, fmt.Errorf("invalid size unit %q", s)
+	}
+
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, err
+	}
+	return value * scale, nil
+}
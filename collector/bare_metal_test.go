@@ -0,0 +1,184 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	responseBareMetals string = `{
+		"bare_metals": [
+			{
+				"id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+				"os": "Ubuntu 22.04 x64",
+				"ram": "32768 MB",
+				"disk": "2x 240 GB SSD",
+				"cpu_count": 8,
+				"region": "ewr",
+				"status": "active",
+				"label": "my-server",
+				"plan": "vbm-8c-32gb"
+			},
+			{
+				"id": "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
+				"os": "Ubuntu 22.04 x64",
+				"ram": "1.5 TB",
+				"disk": "2x 1.9 TB NVMe",
+				"cpu_count": 48,
+				"region": "ewr",
+				"status": "pending",
+				"label": "my-other-server",
+				"plan": "vbm-48c-1536gb"
+			}
+		],
+		"meta": {
+			"total": 2,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	prometheusBareMetal string = `
+	# HELP test_bare_metal_bandwidth_bytes Bandwidth (bytes) used in the current month
+	# TYPE test_bare_metal_bandwidth_bytes gauge
+	test_bare_metal_bandwidth_bytes{direction="incoming",id="14b3e7d6-ffb5-4994-8502-57fcd9db3b33",label="my-other-server",plan="vbm-48c-1536gb",region="ewr"} 0
+	test_bare_metal_bandwidth_bytes{direction="incoming",id="cb676a46-66fd-4dfb-b839-443f2e6c0b60",label="my-server",plan="vbm-8c-32gb",region="ewr"} 3000
+	test_bare_metal_bandwidth_bytes{direction="outgoing",id="14b3e7d6-ffb5-4994-8502-57fcd9db3b33",label="my-other-server",plan="vbm-48c-1536gb",region="ewr"} 0
+	test_bare_metal_bandwidth_bytes{direction="outgoing",id="cb676a46-66fd-4dfb-b839-443f2e6c0b60",label="my-server",plan="vbm-8c-32gb",region="ewr"} 300
+	# HELP test_bare_metal_cpu_count Number of CPUs
+	# TYPE test_bare_metal_cpu_count gauge
+	test_bare_metal_cpu_count{id="14b3e7d6-ffb5-4994-8502-57fcd9db3b33",label="my-other-server",plan="vbm-48c-1536gb",region="ewr"} 48
+	test_bare_metal_cpu_count{id="cb676a46-66fd-4dfb-b839-443f2e6c0b60",label="my-server",plan="vbm-8c-32gb",region="ewr"} 8
+	# HELP test_bare_metal_disk_info A metric with a constant '1' value labeled by the server's disk configuration
+	# TYPE test_bare_metal_disk_info gauge
+	test_bare_metal_disk_info{disk="2x 1.9 TB NVMe",id="14b3e7d6-ffb5-4994-8502-57fcd9db3b33",label="my-other-server",plan="vbm-48c-1536gb",region="ewr"} 1
+	test_bare_metal_disk_info{disk="2x 240 GB SSD",id="cb676a46-66fd-4dfb-b839-443f2e6c0b60",label="my-server",plan="vbm-8c-32gb",region="ewr"} 1
+	# HELP test_bare_metal_ram Size (MB) of RAM
+	# TYPE test_bare_metal_ram gauge
+	test_bare_metal_ram{id="14b3e7d6-ffb5-4994-8502-57fcd9db3b33",label="my-other-server",plan="vbm-48c-1536gb",region="ewr"} 1.572864e+06
+	test_bare_metal_ram{id="cb676a46-66fd-4dfb-b839-443f2e6c0b60",label="my-server",plan="vbm-8c-32gb",region="ewr"} 32768
+	# HELP test_bare_metal_up Bare Metal server
+	# TYPE test_bare_metal_up counter
+	test_bare_metal_up{id="14b3e7d6-ffb5-4994-8502-57fcd9db3b33",label="my-other-server",os="Ubuntu 22.04 x64",plan="vbm-48c-1536gb",region="ewr",status="pending"} 0
+	test_bare_metal_up{id="cb676a46-66fd-4dfb-b839-443f2e6c0b60",label="my-server",os="Ubuntu 22.04 x64",plan="vbm-8c-32gb",region="ewr",status="active"} 1
+	`
+)
+
+func TestBareMetalCollector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// Bandwidth is keyed by date
+	// Only days in the current month are summed
+	now := time.Now().UTC()
+	thisMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	responseBareMetalBandwidth := fmt.Sprintf(`{
+		"bandwidth": {
+			%q: {
+				"incoming_bytes": 1000,
+				"outgoing_bytes": 100
+			},
+			%q: {
+				"incoming_bytes": 2000,
+				"outgoing_bytes": 200
+			},
+			%q: {
+				"incoming_bytes": 50000,
+				"outgoing_bytes": 5000
+			}
+		}
+	}`,
+		thisMonth.Format("2006-01-02"),
+		thisMonth.AddDate(0, 0, 1).Format("2006-01-02"),
+		thisMonth.AddDate(0, 0, -1).Format("2006-01-02"),
+	)
+
+	for path, response := range map[string]string{
+		"/v2/bare-metals": responseBareMetals,
+		"/v2/bare-metals/cb676a46-66fd-4dfb-b839-443f2e6c0b60/bandwidth": responseBareMetalBandwidth,
+		"/v2/bare-metals/14b3e7d6-ffb5-4994-8502-57fcd9db3b33/bandwidth": `{"bandwidth": {}}`,
+	} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if _, err := fmt.Fprint(w, response); err != nil {
+				t.Errorf("unable to write response: %v", err)
+			}
+		})
+	}
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	collector := NewBareMetalCollector(s, client, log)
+
+	if err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(prometheusBareMetal),
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
+
+func TestParseMegabytes(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want float64
+		ok   bool
+	}{
+		{
+			s:    "65536 MB",
+			want: 65536,
+			ok:   true,
+		},
+		{
+			s:    "64 GB",
+			want: 65536,
+			ok:   true,
+		},
+		{
+			s:    "1.5 TB",
+			want: 1572864,
+			ok:   true,
+		},
+		{
+			s:  "32768MB",
+			ok: false,
+		},
+		{
+			s:  "2x 240 GB SSD",
+			ok: false,
+		},
+		{
+			s:  "65536",
+			ok: false,
+		},
+		{
+			s:  "65536 KB",
+			ok: false,
+		},
+	} {
+		t.Run(test.s, func(t *testing.T) {
+			got, err := parseMegabytes(test.s)
+			if ok := err == nil; ok != test.ok {
+				t.Fatalf("got %t, want %t (%v)", ok, test.ok, err)
+			}
+			if got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
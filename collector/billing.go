@@ -3,6 +3,8 @@ package collector
 import (
 	"context"
 	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -10,6 +12,11 @@ import (
 	"github.com/vultr/govultr/v3"
 )
 
+const (
+	// hourlyBillingCap is the maximum number of hours for which Vultr bills an hourly item in a month
+	hourlyBillingCap float64 = 672
+)
+
 // BillingCollector represents billing related metrics.
 // It manages a set of InvoiceItemCollectors, one per product type and description,
 // and ensures that metrics are properly aggregated and deduplicated
@@ -19,6 +26,11 @@ type BillingCollector struct {
 	Client *govultr.Client
 	Log    logr.Logger
 
+	// Projected (month-end) cost metrics
+	// These are aggregated across invoice items by BillingCollector rather than InvoiceItemCollector
+	Projected      *prometheus.Desc
+	ProjectedTotal *prometheus.Desc
+
 	// Map of product+description to collector to prevent duplicates
 	// Each unique product instance (e.g., different Load Balancers) gets its own collector
 	// to ensure proper metric aggregation and avoid duplicates
@@ -27,10 +39,28 @@ type BillingCollector struct {
 
 // NewBillingCollector creates a new BillingCollector
 func NewBillingCollector(s System, client *govultr.Client, log logr.Logger) *BillingCollector {
+	subsystem := "billing"
 	return &BillingCollector{
-		System:     s,
-		Client:     client,
-		Log:        log,
+		System: s,
+		Client: client,
+		Log:    log,
+
+		Projected: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "projected_cost_usd"),
+			"Total cost in USD projected to the end of the month",
+			[]string{
+				"product",
+				"description",
+			},
+			nil,
+		),
+		ProjectedTotal: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "projected_total_cost_usd"),
+			"Total cost in USD of all products projected to the end of the month",
+			nil,
+			nil,
+		),
+
 		collectors: make(map[string]*InvoiceItemCollector),
 	}
 }
@@ -42,13 +72,19 @@ func getCollectorKey(product, description string) string {
 
 // getAllPendingCharges retrieves all pending charges across all pages
 func (c *BillingCollector) getAllPendingCharges(ctx context.Context) ([]govultr.InvoiceItem, error) {
+	return listPendingCharges(ctx, c.Client)
+}
+
+// listPendingCharges retrieves all pending charges across all pages
+// It is shared by the collectors that use the current month's charges
+func listPendingCharges(ctx context.Context, client *govultr.Client) ([]govultr.InvoiceItem, error) {
 	var allItems []govultr.InvoiceItem
 	options := &govultr.ListOptions{
 		PerPage: 500,
 	}
 
 	for {
-		items, _, err := c.Client.Billing.ListPendingCharges(ctx, options)
+		items, _, err := client.Billing.ListPendingCharges(ctx, options)
 		if err != nil {
 			return nil, err
 		}
@@ -95,6 +131,7 @@ func (c *BillingCollector) Collect(ch chan<- prometheus.Metric) {
 	}
 
 	// Process each product's items through its dedicated collector
+	var projectedTotal float64
 	for key, items := range itemsByKey {
 		collector, exists := c.collectors[key]
 		if !exists {
@@ -112,12 +149,84 @@ func (c *BillingCollector) Collect(ch chan<- prometheus.Metric) {
 		}
 		// Then emit the aggregated metrics once per product
 		collector.EmitMetrics(ch)
+
+		// Project each item to the end of the month
+		var projected float64
+		for _, item := range items {
+			cost, err := projectedCost(item)
+			if err != nil {
+				c.Log.Error(err, "Unable to project cost",
+					"product", item.Product,
+					"description", item.Description,
+				)
+				cost = float64(item.Total)
+			}
+			projected += cost
+		}
+		ch <- prometheus.MustNewConstMetric(
+			c.Projected,
+			prometheus.GaugeValue,
+			projected,
+			[]string{
+				items[0].Product,
+				items[0].Description,
+			}...,
+		)
+		projectedTotal += projected
 	}
+
+	ch <- prometheus.MustNewConstMetric(
+		c.ProjectedTotal,
+		prometheus.GaugeValue,
+		projectedTotal,
+	)
 }
 
 // Describe implements Prometheus' Collector interface and is used to describe metrics
 func (c *BillingCollector) Describe(ch chan<- *prometheus.Desc) {
-	for _, collector := range c.collectors {
-		collector.Describe(ch)
+	// InvoiceItemCollectors are created during Collect
+	// Their metrics are described by an otherwise unused InvoiceItemCollector
+	NewInvoiceItemCollector(System{
+		Namespace: c.System.Namespace,
+		Subsystem: "billing",
+		Version:   c.System.Version,
+	}, c.Client, c.Log).Describe(ch)
+
+	ch <- c.Projected
+	ch <- c.ProjectedTotal
+}
+
+// projectedCost projects the cost of an invoice item to the end of the calendar month in which it started
+// Hourly items accrue their unit price for each of the month's remaining hours
+// up to Vultr's monthly cap of hourlyBillingCap hours
+// Other items are extrapolated from the cost accrued between their start and end dates
+func projectedCost(item *govultr.InvoiceItem) (float64, error) {
+	start, err := parseTime(item.StartDate)
+	if err != nil {
+		return 0, err
+	}
+	end, err := parseTime(item.EndDate)
+	if err != nil {
+		return 0, err
+	}
+
+	total := float64(item.Total)
+
+	monthEnd := time.Date(start.Year(), start.Month()+1, 1, 0, 0, 0, 0, start.Location())
+	remaining := monthEnd.Sub(end)
+	if remaining <= 0 {
+		return total, nil
+	}
+
+	if strings.HasPrefix(item.UnitType, "hour") {
+		projected := total + float64(item.UnitPrice)*remaining.Hours()
+		limit := math.Max(total, float64(item.UnitPrice)*hourlyBillingCap)
+		return math.Min(projected, limit), nil
+	}
+
+	elapsed := end.Sub(start)
+	if elapsed <= 0 {
+		return total, nil
 	}
+	return total * float64(monthEnd.Sub(start)) / float64(elapsed), nil
 }
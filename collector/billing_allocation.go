@@ -0,0 +1,351 @@
+package collector
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+// allocationResource represents a resource to which invoice items may be allocated
+type allocationResource struct {
+	Kind   string
+	ID     string
+	Region string
+	Tags   []string
+}
+
+// allocationIndex indexes resources by the names by which invoice items describe them
+// Invoice item descriptions include a resource's name in parentheses (e.g. "Load Balancer (my-loadbalancer)")
+type allocationIndex struct {
+	mu        sync.Mutex
+	resources map[string][]allocationResource
+}
+
+// add indexes the resource by each of its (non-empty) names
+func (x *allocationIndex) add(r allocationResource, names ...string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	seen := make(map[string]bool)
+	for _, name := range names {
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		x.resources[name] = append(x.resources[name], r)
+	}
+}
+
+// Match returns the resource described by the invoice item
+// If the item's product is billed for a known kind of resource, only resources of that kind are matched
+// If the item can't be matched to exactly one resource, false is returned
+func (x *allocationIndex) Match(item *govultr.InvoiceItem) (allocationResource, bool) {
+	candidates := x.resources[descriptionName(item.Description)]
+
+	kind := productKind(item.Product)
+	var matches []allocationResource
+	for _, candidate := range candidates {
+		if kind == "" || candidate.Kind == kind {
+			matches = append(matches, candidate)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0], true
+	}
+
+	return allocationResource{}, false
+}
+
+// descriptionName returns the name in (the last) parentheses of an invoice item's description
+// e.g. "Load Balancer (my-loadbalancer)" returns "my-loadbalancer"
+func descriptionName(description string) string {
+	end := strings.LastIndex(description, ")")
+	if end == -1 {
+		return ""
+	}
+	start := strings.LastIndex(description[:end], "(")
+	if start == -1 {
+		return ""
+	}
+	return strings.TrimSpace(description[start+1 : end])
+}
+
+// productKind returns the kind of resource billed by an invoice item's product
+// If the product isn't recognized, "" is returned
+func productKind(product string) string {
+	p := strings.ToLower(product)
+	switch {
+	case strings.Contains(p, "load balancer"):
+		return "load_balancer"
+	case strings.Contains(p, "block"):
+		return "block_storage"
+	case strings.Contains(p, "kubernetes"):
+		return "kubernetes_cluster"
+	case strings.Contains(p, "compute"), strings.Contains(p, "gpu"), strings.Contains(p, "instance"):
+		return "instance"
+	default:
+		return ""
+	}
+}
+
+// BillingAllocationCollector represents pending charges allocated to the resources that incurred them
+type BillingAllocationCollector struct {
+	System    System
+	Client    *govultr.Client
+	Log       logr.Logger
+	Allocated *prometheus.Desc
+}
+
+// NewBillingAllocationCollector creates a new BillingAllocationCollector
+func NewBillingAllocationCollector(s System, client *govultr.Client, log logr.Logger) *BillingAllocationCollector {
+	subsystem := "billing"
+	return &BillingAllocationCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Allocated: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "allocated_cost_usd"),
+			"Total cost in USD allocated to the resource that incurred it",
+			[]string{
+				"product",
+				"description",
+				"kind",
+				"id",
+				"region",
+				"tags",
+			},
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *BillingAllocationCollector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	charges, err := listPendingCharges(ctx, c.Client)
+	if err != nil {
+		log.Error(err, "Unable to Billing.ListPendingCharges")
+		return
+	}
+
+	index := c.index(ctx)
+
+	// Sum the charges by product+description
+	// Each product+description is allocated to (at most) one resource
+	totals := make(map[string]float64)
+	items := make(map[string]*govultr.InvoiceItem)
+	for i := range charges {
+		item := &charges[i]
+		key := getCollectorKey(item.Product, item.Description)
+		totals[key] += float64(item.Total)
+		items[key] = item
+	}
+
+	for key, total := range totals {
+		item := items[key]
+
+		// Unmatched charges are reported without a resource
+		// This ensures that the allocated costs sum to the total cost
+		resource, ok := index.Match(item)
+		if !ok {
+			log.V(1).Info("Unable to match charge to a resource",
+				"product", item.Product,
+				"description", item.Description,
+			)
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			c.Allocated,
+			prometheus.GaugeValue,
+			total,
+			[]string{
+				item.Product,
+				item.Description,
+				resource.Kind,
+				resource.ID,
+				resource.Region,
+				strings.Join(resource.Tags, ","),
+			}...,
+		)
+	}
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *BillingAllocationCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Allocated
+}
+
+// index indexes the account's Instances, Load Balancers, Block Storage and Kubernetes clusters
+// Resources that can't be listed are logged and omitted
+func (c *BillingAllocationCollector) index(ctx context.Context) *allocationIndex {
+	log := c.Log.WithName("index")
+
+	index := &allocationIndex{
+		resources: make(map[string][]allocationResource),
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(4)
+
+	// Instances
+	go func() {
+		defer wg.Done()
+
+		options := &govultr.ListOptions{
+			PerPage: 100,
+		}
+
+		for {
+			instances, meta, _, err := c.Client.Instance.List(ctx, options)
+			if err != nil {
+				log.Error(err, "Unable to Instance.List")
+				return
+			}
+
+			for _, instance := range instances {
+				index.add(allocationResource{
+					Kind:   "instance",
+					ID:     instance.ID,
+					Region: instance.Region,
+					Tags:   instance.Tags,
+				}, instance.Label, instance.Hostname, instance.MainIP, instance.ID)
+			}
+
+			// If we've received all items or there's no next page, break
+			if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+				break
+			}
+
+			// Move to next page
+			options.Cursor = meta.Links.Next
+		}
+	}()
+
+	// Load Balancers
+	go func() {
+		defer wg.Done()
+
+		options := &govultr.ListOptions{
+			PerPage: 100,
+		}
+
+		for {
+			loadbalancers, meta, _, err := c.Client.LoadBalancer.List(ctx, options)
+			if err != nil {
+				log.Error(err, "Unable to LoadBalancer.List")
+				return
+			}
+
+			for _, lb := range loadbalancers {
+				index.add(allocationResource{
+					Kind:   "load_balancer",
+					ID:     lb.ID,
+					Region: lb.Region,
+				}, lb.Label, lb.IPV4, lb.ID)
+			}
+
+			// If we've received all items or there's no next page, break
+			if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+				break
+			}
+
+			// Move to next page
+			options.Cursor = meta.Links.Next
+		}
+	}()
+
+	// Block Storage
+	go func() {
+		defer wg.Done()
+
+		options := &govultr.ListOptions{
+			PerPage: 100,
+		}
+
+		for {
+			blocks, meta, _, err := c.Client.BlockStorage.List(ctx, options)
+			if err != nil {
+				log.Error(err, "Unable to BlockStorage.List")
+				return
+			}
+
+			for _, block := range blocks {
+				index.add(allocationResource{
+					Kind:   "block_storage",
+					ID:     block.ID,
+					Region: block.Region,
+				}, block.Label, block.ID)
+			}
+
+			// If we've received all items or there's no next page, break
+			if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+				break
+			}
+
+			// Move to next page
+			options.Cursor = meta.Links.Next
+		}
+	}()
+
+	// Kubernetes clusters
+	// Clusters are tagged with their Node Pools' tags
+	go func() {
+		defer wg.Done()
+
+		options := &govultr.ListOptions{
+			PerPage: 100,
+		}
+
+		for {
+			clusters, meta, _, err := c.Client.Kubernetes.ListClusters(ctx, options)
+			if err != nil {
+				log.Error(err, "Unable to Kubernetes.ListClusters")
+				return
+			}
+
+			for _, cluster := range clusters {
+				index.add(allocationResource{
+					Kind:   "kubernetes_cluster",
+					ID:     cluster.ID,
+					Region: cluster.Region,
+					Tags:   nodePoolTags(cluster.NodePools),
+				}, cluster.Label, cluster.ID)
+			}
+
+			// If we've received all items or there's no next page, break
+			if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+				break
+			}
+
+			// Move to next page
+			options.Cursor = meta.Links.Next
+		}
+	}()
+
+	wg.Wait()
+	return index
+}
+
+// nodePoolTags returns the (sorted, distinct, non-empty) tags of the Node Pools
+func nodePoolTags(nodepools []govultr.NodePool) []string {
+	seen := make(map[string]bool)
+	var tags []string
+	for _, nodepool := range nodepools {
+		if nodepool.Tag == "" || seen[nodepool.Tag] {
+			continue
+		}
+		seen[nodepool.Tag] = true
+		tags = append(tags, nodepool.Tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
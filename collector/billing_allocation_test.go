@@ -0,0 +1,286 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/vultr/govultr/v3"
+)
+
+var (
+	responseAllocationPendingCharges string = `{
+		"pending_charges": [
+			{
+				"description": "Load Balancer (my-loadbalancer)",
+				"product": "Load Balancer",
+				"units": 720,
+				"unit_type": "hours",
+				"unit_price": 0.015,
+				"total": 10
+			},
+			{
+				"description": "Cloud Compute (my-instance)",
+				"product": "Vultr Cloud Compute",
+				"units": 720,
+				"unit_type": "hours",
+				"unit_price": 0.007,
+				"total": 5
+			},
+			{
+				"description": "Block Storage (my-volume)",
+				"product": "Block Storage",
+				"units": 720,
+				"unit_type": "hours",
+				"unit_price": 0.003,
+				"total": 2.5
+			},
+			{
+				"description": "Block Storage (my-cluster)",
+				"product": "Block Storage",
+				"units": 720,
+				"unit_type": "hours",
+				"unit_price": 0.003,
+				"total": 1
+			},
+			{
+				"description": "Vultr Kubernetes Engine (my-cluster)",
+				"product": "Vultr Kubernetes Engine",
+				"units": 720,
+				"unit_type": "hours",
+				"unit_price": 0.014,
+				"total": 10
+			},
+			{
+				"description": "Load Balancer (my-instance)",
+				"product": "Load Balancer",
+				"units": 720,
+				"unit_type": "hours",
+				"unit_price": 0.015,
+				"total": 3
+			},
+			{
+				"description": "Bandwidth Overage",
+				"product": "Bandwidth",
+				"units": 10,
+				"unit_type": "GB",
+				"unit_price": 0.01,
+				"total": 0.5
+			}
+		]
+	}`
+	responseAllocationInstances string = `{
+		"instances": [
+			{
+				"id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+				"label": "my-instance",
+				"region": "ewr",
+				"tags": ["team-a", "prod"]
+			}
+		],
+		"meta": {
+			"total": 1,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseAllocationLoadBalancers string = `{
+		"load_balancers": [
+			{
+				"id": "1317575f-e8a3-4bfb-9d2e-4aaf2f3d8f7c",
+				"region": "lax",
+				"label": "my-loadbalancer"
+			}
+		],
+		"meta": {
+			"total": 1,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseAllocationBlocks string = `{
+		"blocks": [
+			{
+				"id": "c2a0d6ad-8ad0-4b8e-9a2f-2e2f8b5c1d3a",
+				"label": "my-volume",
+				"region": "ewr"
+			},
+			{
+				"id": "e0a05b4a-8b49-4b3c-9a1a-7b1d6d1e7c2f",
+				"label": "my-cluster",
+				"region": "ewr"
+			}
+		],
+		"meta": {
+			"total": 2,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseAllocationClusters string = `{
+		"vke_clusters": [
+			{
+				"id": "455dcd32-e621-48ee-a10e-0cb5f2d9c1f3",
+				"label": "my-cluster",
+				"region": "ams",
+				"node_pools": [
+					{
+						"label": "default",
+						"tag": "team-b"
+					},
+					{
+						"label": "gpu",
+						"tag": "team-a"
+					}
+				]
+			}
+		],
+		"meta": {
+			"total": 1,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	prometheusBillingAllocation string = `
+	# HELP test_billing_allocated_cost_usd Total cost in USD allocated to the resource that incurred it
+	# TYPE test_billing_allocated_cost_usd gauge
+	test_billing_allocated_cost_usd{description="Bandwidth Overage",id="",kind="",product="Bandwidth",region="",tags=""} 0.5
+	test_billing_allocated_cost_usd{description="Block Storage (my-cluster)",id="e0a05b4a-8b49-4b3c-9a1a-7b1d6d1e7c2f",kind="block_storage",product="Block Storage",region="ewr",tags=""} 1
+	test_billing_allocated_cost_usd{description="Block Storage (my-volume)",id="c2a0d6ad-8ad0-4b8e-9a2f-2e2f8b5c1d3a",kind="block_storage",product="Block Storage",region="ewr",tags=""} 2.5
+	test_billing_allocated_cost_usd{description="Cloud Compute (my-instance)",id="cb676a46-66fd-4dfb-b839-443f2e6c0b60",kind="instance",product="Vultr Cloud Compute",region="ewr",tags="team-a,prod"} 5
+	test_billing_allocated_cost_usd{description="Load Balancer (my-instance)",id="",kind="",product="Load Balancer",region="",tags=""} 3
+	test_billing_allocated_cost_usd{description="Load Balancer (my-loadbalancer)",id="1317575f-e8a3-4bfb-9d2e-4aaf2f3d8f7c",kind="load_balancer",product="Load Balancer",region="lax",tags=""} 10
+	test_billing_allocated_cost_usd{description="Vultr Kubernetes Engine (my-cluster)",id="455dcd32-e621-48ee-a10e-0cb5f2d9c1f3",kind="kubernetes_cluster",product="Vultr Kubernetes Engine",region="ams",tags="team-a,team-b"} 10
+	`
+)
+
+func TestBillingAllocationCollector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for path, response := range map[string]string{
+		"/v2/billing/pending-charges": responseAllocationPendingCharges,
+		"/v2/instances":               responseAllocationInstances,
+		"/v2/load-balancers":          responseAllocationLoadBalancers,
+		"/v2/blocks":                  responseAllocationBlocks,
+		"/v2/kubernetes/clusters":     responseAllocationClusters,
+	} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if _, err := fmt.Fprint(w, response); err != nil {
+				t.Errorf("unable to write response: %v", err)
+			}
+		})
+	}
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	collector := NewBillingAllocationCollector(s, client, log)
+
+	if err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(prometheusBillingAllocation),
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
+
+func TestDescriptionName(t *testing.T) {
+	for description, want := range map[string]string{
+		"Load Balancer (my-loadbalancer)":        "my-loadbalancer",
+		"Cloud Compute (2 GB) (my-instance)":     "my-instance",
+		"Vultr Kubernetes Engine ( my-cluster )": "my-cluster",
+		"Bandwidth Overage":                      "",
+		"Unbalanced (parentheses":                "",
+	} {
+		t.Run(description, func(t *testing.T) {
+			if got := descriptionName(description); got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAllocationIndexMatch(t *testing.T) {
+	instance := allocationResource{Kind: "instance", ID: "instance"}
+	lb := allocationResource{Kind: "load_balancer", ID: "load_balancer"}
+
+	index := &allocationIndex{
+		resources: make(map[string][]allocationResource),
+	}
+	index.add(instance, "web", "192.0.2.1")
+	index.add(lb, "api")
+	index.add(allocationResource{Kind: "block_storage", ID: "block_storage"}, "db")
+	index.add(allocationResource{Kind: "instance", ID: "db"}, "db")
+
+	for _, test := range []struct {
+		name string
+		item govultr.InvoiceItem
+		want allocationResource
+		ok   bool
+	}{
+		{
+			name: "Kind",
+			item: govultr.InvoiceItem{Product: "Vultr Cloud Compute", Description: "Cloud Compute (web)"},
+			want: instance,
+			ok:   true,
+		},
+		{
+			// A deleted Load Balancer must not be charged to an Instance with the same label
+			name: "Mismatch",
+			item: govultr.InvoiceItem{Product: "Load Balancer", Description: "Load Balancer (web)"},
+			ok:   false,
+		},
+		{
+			name: "Address",
+			item: govultr.InvoiceItem{Product: "Block Storage", Description: "Block Storage (192.0.2.1)"},
+			ok:   false,
+		},
+		{
+			name: "Ambiguous",
+			item: govultr.InvoiceItem{Product: "Block Storage", Description: "Block Storage (db)"},
+			want: allocationResource{Kind: "block_storage", ID: "block_storage"},
+			ok:   true,
+		},
+		{
+			name: "Unknown",
+			item: govultr.InvoiceItem{Product: "Snapshot", Description: "Snapshot (api)"},
+			want: lb,
+			ok:   true,
+		},
+		{
+			name: "Missing",
+			item: govultr.InvoiceItem{Product: "Load Balancer", Description: "Load Balancer (missing)"},
+			ok:   false,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := index.Match(&test.item)
+			if ok != test.ok {
+				t.Fatalf("got %t, want %t", ok, test.ok)
+			}
+			if got.Kind != test.want.Kind || got.ID != test.want.ID {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,316 @@
+package collector
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+// BillingHistoryCollector represents past Invoices and the account's billing history (credits, payments)
+// Only Invoices and history from the last Months months are collected
+type BillingHistoryCollector struct {
+	System System
+	Client *govultr.Client
+	Log    logr.Logger
+	Months int
+
+	InvoiceAmount      *prometheus.Desc
+	InvoiceTime        *prometheus.Desc
+	InvoiceProductCost *prometheus.Desc
+	Credits            *prometheus.Desc
+	Payments           *prometheus.Desc
+}
+
+// NewBillingHistoryCollector creates a new BillingHistoryCollector
+func NewBillingHistoryCollector(s System, client *govultr.Client, months int, log logr.Logger) *BillingHistoryCollector {
+	subsystem := "billing_history"
+	return &BillingHistoryCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Months: months,
+
+		InvoiceAmount: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "invoice_amount_usd"),
+			"Amount (USD) of Invoice",
+			[]string{
+				"id",
+				"month",
+			},
+			nil,
+		),
+		InvoiceTime: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "invoice_time"),
+			"Date of Invoice in Unix epoch seconds",
+			[]string{
+				"id",
+				"month",
+			},
+			nil,
+		),
+		InvoiceProductCost: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "invoice_product_cost_usd"),
+			"Total cost (USD) of product's Invoice items",
+			[]string{
+				"id",
+				"month",
+				"product",
+			},
+			nil,
+		),
+		Credits: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "credits_usd"),
+			"Total amount (USD) of credits applied in the month",
+			[]string{
+				"month",
+			},
+			nil,
+		),
+		Payments: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "payments_usd"),
+			"Total amount (USD) of payments made in the month",
+			[]string{
+				"month",
+			},
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *BillingHistoryCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx := context.Background()
+
+	since := time.Now().AddDate(0, -c.Months, 0)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		c.collectInvoices(ctx, ch, since)
+	}()
+	go func() {
+		defer wg.Done()
+		c.collectHistory(ctx, ch, since)
+	}()
+	wg.Wait()
+}
+
+// collectInvoices collects Invoices (and their items) dated after since
+func (c *BillingHistoryCollector) collectInvoices(ctx context.Context, ch chan<- prometheus.Metric, since time.Time) {
+	log := c.Log.WithName("collectInvoices")
+
+	// Get the invoices dated after since
+	// Invoices are listed newest first so paging stops at the first page that includes an older Invoice
+	var allInvoices []govultr.Invoice
+	dates := make(map[int]time.Time)
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		invoices, meta, _, err := c.Client.Billing.ListInvoices(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to Billing.ListInvoices")
+			return
+		}
+
+		older := false
+		for _, invoice := range invoices {
+			date, err := parseTime(invoice.Date)
+			if err != nil {
+				log.Error(err, "Unable to parse Invoice date",
+					"id", invoice.ID,
+				)
+				continue
+			}
+			if date.Before(since) {
+				older = true
+				continue
+			}
+
+			dates[invoice.ID] = date
+			allInvoices = append(allInvoices, invoice)
+		}
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+		// If the remaining pages are older than since, break
+		if older {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	// Enumerate the invoices
+	var wg sync.WaitGroup
+	for _, invoice := range allInvoices {
+		date := dates[invoice.ID]
+
+		wg.Add(1)
+		go func(invoice govultr.Invoice, date time.Time) {
+			defer wg.Done()
+
+			id := strconv.Itoa(invoice.ID)
+			month := date.Format("2006-01")
+
+			ch <- prometheus.MustNewConstMetric(
+				c.InvoiceAmount,
+				prometheus.GaugeValue,
+				float64(invoice.Amount),
+				[]string{
+					id,
+					month,
+				}...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.InvoiceTime,
+				prometheus.GaugeValue,
+				float64(date.Unix()),
+				[]string{
+					id,
+					month,
+				}...,
+			)
+
+			// Sum the invoice's items by product
+			totals := make(map[string]float64)
+			options := &govultr.ListOptions{
+				PerPage: 500,
+			}
+
+			for {
+				items, meta, _, err := c.Client.Billing.ListInvoiceItems(ctx, invoice.ID, options)
+				if err != nil {
+					log.Error(err, "Unable to Billing.ListInvoiceItems",
+						"id", invoice.ID,
+					)
+					return
+				}
+
+				for _, item := range items {
+					totals[item.Product] += float64(item.Total)
+				}
+
+				// If we've received all items or there's no next page, break
+				if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+					break
+				}
+
+				// Move to next page
+				options.Cursor = meta.Links.Next
+			}
+
+			for product, total := range totals {
+				ch <- prometheus.MustNewConstMetric(
+					c.InvoiceProductCost,
+					prometheus.GaugeValue,
+					total,
+					[]string{
+						id,
+						month,
+						product,
+					}...,
+				)
+			}
+		}(invoice, date)
+	}
+	wg.Wait()
+}
+
+// collectHistory collects the credits and payments in the billing history dated after since
+func (c *BillingHistoryCollector) collectHistory(ctx context.Context, ch chan<- prometheus.Metric, since time.Time) {
+	log := c.Log.WithName("collectHistory")
+
+	// Sum credits and payments by month
+	credits := make(map[string]float64)
+	payments := make(map[string]float64)
+
+	// Billing history is listed newest first so paging stops at the first page that includes an older entry
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		history, meta, _, err := c.Client.Billing.ListHistory(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to Billing.ListHistory")
+			return
+		}
+
+		older := false
+		for _, entry := range history {
+			date, err := parseTime(entry.Date)
+			if err != nil {
+				log.Error(err, "Unable to parse billing history date",
+					"id", entry.ID,
+				)
+				continue
+			}
+			if date.Before(since) {
+				older = true
+				continue
+			}
+
+			month := date.Format("2006-01")
+			switch entry.Type {
+			case "credit":
+				credits[month] += float64(entry.Amount)
+			case "payment":
+				payments[month] += float64(entry.Amount)
+			}
+		}
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// If the remaining pages are older than since, break
+		if older {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	for month, amount := range credits {
+		ch <- prometheus.MustNewConstMetric(
+			c.Credits,
+			prometheus.GaugeValue,
+			amount,
+			[]string{
+				month,
+			}...,
+		)
+	}
+	for month, amount := range payments {
+		ch <- prometheus.MustNewConstMetric(
+			c.Payments,
+			prometheus.GaugeValue,
+			amount,
+			[]string{
+				month,
+			}...,
+		)
+	}
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *BillingHistoryCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.InvoiceAmount
+	ch <- c.InvoiceTime
+	ch <- c.InvoiceProductCost
+	ch <- c.Credits
+	ch <- c.Payments
+}
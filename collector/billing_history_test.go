@@ -0,0 +1,185 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	responseBillingHistory string = `{
+		"billing_history": [
+			{
+				"id": 3,
+				"date": "2024-02-04T00:00:00+00:00",
+				"type": "payment",
+				"description": "Payment",
+				"amount": -5.5,
+				"balance": 0
+			},
+			{
+				"id": 2,
+				"date": "2024-02-03T00:00:00+00:00",
+				"type": "payment",
+				"description": "Payment",
+				"amount": -20,
+				"balance": 5.5
+			},
+			{
+				"id": 1,
+				"date": "2024-02-01T00:00:00+00:00",
+				"type": "invoice",
+				"description": "Invoice #2",
+				"amount": 25.5,
+				"balance": 25.5
+			},
+			{
+				"id": 4,
+				"date": "2024-01-15T00:00:00+00:00",
+				"type": "credit",
+				"description": "Promotional credit",
+				"amount": -10,
+				"balance": -10
+			},
+			{
+				"id": 5,
+				"date": "1970-01-15T00:00:00+00:00",
+				"type": "credit",
+				"description": "Too old",
+				"amount": -100,
+				"balance": -100
+			}
+		],
+		"meta": {
+			"total": 5,
+			"links": {
+				"next": "page2",
+				"prev": ""
+			}
+		}
+	}`
+	responseBillingInvoices string = `{
+		"billing_invoices": [
+			{
+				"id": 2,
+				"date": "2024-02-01T00:00:00+00:00",
+				"description": "Invoice #2",
+				"amount": 25.5,
+				"balance": 0
+			},
+			{
+				"id": 1,
+				"date": "1970-01-01T00:00:00+00:00",
+				"description": "Too old",
+				"amount": 100,
+				"balance": 0
+			}
+		],
+		"meta": {
+			"total": 2,
+			"links": {
+				"next": "page2",
+				"prev": ""
+			}
+		}
+	}`
+	responseBillingInvoiceItems string = `{
+		"invoice_items": [
+			{
+				"description": "Load Balancer (my-loadbalancer)",
+				"product": "Load Balancer",
+				"units": 720,
+				"unit_type": "hours",
+				"unit_price": 0.015,
+				"total": 10
+			},
+			{
+				"description": "Cloud Compute (my-instance)",
+				"product": "Vultr Cloud Compute",
+				"units": 720,
+				"unit_type": "hours",
+				"unit_price": 0.007,
+				"total": 5
+			},
+			{
+				"description": "Cloud Compute (my-other-instance)",
+				"product": "Vultr Cloud Compute",
+				"units": 720,
+				"unit_type": "hours",
+				"unit_price": 0.015,
+				"total": 10.5
+			}
+		],
+		"meta": {
+			"total": 3,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	prometheusBillingHistory string = `
+	# HELP test_billing_history_credits_usd Total amount (USD) of credits applied in the month
+	# TYPE test_billing_history_credits_usd gauge
+	test_billing_history_credits_usd{month="2024-01"} -10
+	# HELP test_billing_history_invoice_amount_usd Amount (USD) of Invoice
+	# TYPE test_billing_history_invoice_amount_usd gauge
+	test_billing_history_invoice_amount_usd{id="2",month="2024-02"} 25.5
+	# HELP test_billing_history_invoice_product_cost_usd Total cost (USD) of product's Invoice items
+	# TYPE test_billing_history_invoice_product_cost_usd gauge
+	test_billing_history_invoice_product_cost_usd{id="2",month="2024-02",product="Load Balancer"} 10
+	test_billing_history_invoice_product_cost_usd{id="2",month="2024-02",product="Vultr Cloud Compute"} 15.5
+	# HELP test_billing_history_invoice_time Date of Invoice in Unix epoch seconds
+	# TYPE test_billing_history_invoice_time gauge
+	test_billing_history_invoice_time{id="2",month="2024-02"} 1.7067456e+09
+	# HELP test_billing_history_payments_usd Total amount (USD) of payments made in the month
+	# TYPE test_billing_history_payments_usd gauge
+	test_billing_history_payments_usd{month="2024-02"} -25.5
+	`
+)
+
+func TestBillingHistoryCollector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for path, response := range map[string]string{
+		"/v2/billing/history":          responseBillingHistory,
+		"/v2/billing/invoices":         responseBillingInvoices,
+		"/v2/billing/invoices/2/items": responseBillingInvoiceItems,
+	} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			// Pages following one that includes entries older than the collector's months are not requested
+			if r.URL.Query().Get("cursor") != "" {
+				t.Errorf("unexpected request for %s", r.URL)
+			}
+			if _, err := fmt.Fprint(w, response); err != nil {
+				t.Errorf("unable to write response: %v", err)
+			}
+		})
+	}
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	// Sufficient months to include the 2024 (but not the 1970) entries
+	collector := NewBillingHistoryCollector(s, client, 600, log)
+
+	if err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(prometheusBillingHistory),
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
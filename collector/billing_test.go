@@ -72,6 +72,12 @@ var (
 	# HELP test_billing_cost_usd Total cost in USD
 	# TYPE test_billing_cost_usd gauge
 	test_billing_cost_usd{description="Load Balancer (my-loadbalancer)",product="Load Balancer"} 10
+	# HELP test_billing_projected_cost_usd Total cost in USD projected to the end of the month
+	# TYPE test_billing_projected_cost_usd gauge
+	test_billing_projected_cost_usd{description="Load Balancer (my-loadbalancer)",product="Load Balancer"} 10.012799888849258
+	# HELP test_billing_projected_total_cost_usd Total cost in USD of all products projected to the end of the month
+	# TYPE test_billing_projected_total_cost_usd gauge
+	test_billing_projected_total_cost_usd 10.012799888849258
 	# HELP test_billing_units Number of units consumed
 	# TYPE test_billing_units gauge
 	test_billing_units{description="Load Balancer (my-loadbalancer)",product="Load Balancer",unit_price="0.014900",unit_type="hours"} 720
@@ -109,3 +115,80 @@ func TestBillingCollector(t *testing.T) {
 		t.Errorf("unexpected collecting result:\n%s", err)
 	}
 }
+
+func TestProjectedCost(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		item govultr.InvoiceItem
+		want float64
+	}{
+		{
+			name: "Hourly",
+			item: govultr.InvoiceItem{
+				StartDate: "2024-04-15T00:00:00+00:00",
+				EndDate:   "2024-04-20T00:00:00+00:00",
+				UnitType:  "hours",
+				UnitPrice: 0.5,
+				Total:     60,
+			},
+			// 264 remaining hours
+			want: 192,
+		},
+		{
+			name: "Hourly full month",
+			item: govultr.InvoiceItem{
+				StartDate: "2024-03-01T00:00:00+00:00",
+				EndDate:   "2024-03-11T00:00:00+00:00",
+				UnitType:  "hours",
+				UnitPrice: 0.5,
+				Total:     120,
+			},
+			// 744 hours are capped at 672 hours
+			want: 336,
+		},
+		{
+			name: "Linear",
+			item: govultr.InvoiceItem{
+				StartDate: "2024-04-01T00:00:00+00:00",
+				EndDate:   "2024-04-11T00:00:00+00:00",
+				UnitType:  "GB",
+				UnitPrice: 0.01,
+				Total:     5,
+			},
+			// 10 of 30 days elapsed
+			want: 15,
+		},
+		{
+			name: "Month ended",
+			item: govultr.InvoiceItem{
+				StartDate: "2024-04-01T00:00:00+00:00",
+				EndDate:   "2024-05-01T00:00:00+00:00",
+				UnitType:  "hours",
+				UnitPrice: 0.5,
+				Total:     360,
+			},
+			want: 360,
+		},
+		{
+			name: "No elapsed time",
+			item: govultr.InvoiceItem{
+				StartDate: "2024-04-11T00:00:00+00:00",
+				EndDate:   "2024-04-11T00:00:00+00:00",
+				UnitType:  "GB",
+				UnitPrice: 0.01,
+				Total:     5,
+			},
+			want: 5,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := projectedCost(&test.item)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != test.want {
+				t.Errorf("got %f, want %f", got, test.want)
+			}
+		})
+	}
+}
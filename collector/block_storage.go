@@ -11,11 +11,12 @@ import (
 
 // BlockStorageCollector represents Block Storage
 type BlockStorageCollector struct {
-	System System
-	Client *govultr.Client
-	Log    logr.Logger
-	Up     *prometheus.Desc
-	Block  *prometheus.Desc
+	System   System
+	Client   *govultr.Client
+	Log      logr.Logger
+	Up       *prometheus.Desc
+	Block    *prometheus.Desc
+	Attached *prometheus.Desc
 }
 
 // NewBlockStorageCollector create a new BlockStorageCollector
@@ -47,6 +48,18 @@ func NewBlockStorageCollector(s System, client *govultr.Client, log logr.Logger)
 			},
 			nil,
 		),
+		Attached: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "attached"),
+			"Whether Block Storage is attached to an Instance (1=attached)",
+			[]string{
+				"id",
+				"label",
+				"region",
+				"block_type",
+				"instance_id",
+			},
+			nil,
+		),
 	}
 }
 
@@ -112,6 +125,23 @@ func (c *BlockStorageCollector) Collect(ch chan<- prometheus.Metric) {
 					block.BlockType,
 				}...,
 			)
+			ch <- prometheus.MustNewConstMetric(
+				c.Attached,
+				prometheus.GaugeValue,
+				func(instanceID string) (result float64) {
+					if instanceID != "" {
+						result = 1.0
+					}
+					return result
+				}(block.AttachedToInstance),
+				[]string{
+					block.ID,
+					block.Label,
+					block.Region,
+					block.BlockType,
+					block.AttachedToInstance,
+				}...,
+			)
 		}(block)
 	}
 	wg.Wait()
@@ -121,4 +151,5 @@ func (c *BlockStorageCollector) Collect(ch chan<- prometheus.Metric) {
 func (c *BlockStorageCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- c.Up
 	ch <- c.Block
+	ch <- c.Attached
 }
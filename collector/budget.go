@@ -0,0 +1,181 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+const (
+	// budgetTotal is the product label of the account's Budget
+	budgetTotal string = "total"
+)
+
+// Budget represents monthly budgets (USD) for the account (Total) and for products (Products)
+// Products are keyed by the product of invoice items (e.g. "Load Balancer")
+type Budget struct {
+	Total    float64            `json:"total"`
+	Products map[string]float64 `json:"products"`
+}
+
+// ReadBudget reads a Budget from a JSON file
+// Products may not be empty nor "total" because these would be indistinguishable from the account's Budget
+func ReadBudget(path string) (*Budget, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	budget := &Budget{}
+	if err := json.Unmarshal(b, budget); err != nil {
+		return nil, err
+	}
+
+	for product := range budget.Products {
+		if product == "" || product == budgetTotal {
+			return nil, fmt.Errorf("invalid product %q in budget", product)
+		}
+	}
+
+	return budget, nil
+}
+
+// BudgetCollector represents spending against monthly Budgets
+// The account's Budget is labeled product="total"
+type BudgetCollector struct {
+	System    System
+	Client    *govultr.Client
+	Log       logr.Logger
+	Budget    *Budget
+	Limit     *prometheus.Desc
+	UsedRatio *prometheus.Desc
+	BurnRate  *prometheus.Desc
+}
+
+// NewBudgetCollector creates a new BudgetCollector
+func NewBudgetCollector(s System, client *govultr.Client, budget *Budget, log logr.Logger) *BudgetCollector {
+	subsystem := "budget"
+	return &BudgetCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Budget: budget,
+		Limit: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "limit_usd"),
+			"Monthly budget (USD)",
+			[]string{
+				"product",
+			},
+			nil,
+		),
+		UsedRatio: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "used_ratio"),
+			"Ratio of spend-to-date to the monthly budget",
+			[]string{
+				"product",
+			},
+			nil,
+		),
+		BurnRate: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "burn_rate"),
+			"Ratio of the budget used to the fraction of the month elapsed (>1 exceeds budget by month end)",
+			[]string{
+				"product",
+			},
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *BudgetCollector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx := context.Background()
+
+	charges, err := listPendingCharges(ctx, c.Client)
+	if err != nil {
+		log.Error(err, "Unable to Billing.ListPendingCharges")
+		return
+	}
+
+	// Sum the spend-to-date by product
+	var total float64
+	spend := make(map[string]float64)
+	for _, charge := range charges {
+		spend[charge.Product] += float64(charge.Total)
+		total += float64(charge.Total)
+	}
+
+	elapsed := monthElapsed(time.Now())
+
+	// Products' budgets
+	for product, limit := range c.Budget.Products {
+		c.collectBudget(ch, product, limit, spend[product], elapsed)
+	}
+
+	// The account's budget is labeled product="total"
+	if c.Budget.Total > 0 {
+		c.collectBudget(ch, budgetTotal, c.Budget.Total, total, elapsed)
+	}
+}
+
+// collectBudget collects the metrics of a product's budget given its spend-to-date (used)
+func (c *BudgetCollector) collectBudget(ch chan<- prometheus.Metric, product string, limit, used, elapsed float64) {
+	ch <- prometheus.MustNewConstMetric(
+		c.Limit,
+		prometheus.GaugeValue,
+		limit,
+		[]string{
+			product,
+		}...,
+	)
+
+	// Ratios are undefined for zero budgets
+	if limit <= 0 {
+		return
+	}
+
+	ratio := used / limit
+	ch <- prometheus.MustNewConstMetric(
+		c.UsedRatio,
+		prometheus.GaugeValue,
+		ratio,
+		[]string{
+			product,
+		}...,
+	)
+
+	// Burn rate is undefined at the very start of the month
+	if elapsed <= 0 {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(
+		c.BurnRate,
+		prometheus.GaugeValue,
+		ratio/elapsed,
+		[]string{
+			product,
+		}...,
+	)
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *BudgetCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Limit
+	ch <- c.UsedRatio
+	ch <- c.BurnRate
+}
+
+// monthElapsed returns the fraction [0,1) of the (UTC) calendar month that has elapsed at now
+func monthElapsed(now time.Time) float64 {
+	now = now.UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+	return float64(now.Sub(start)) / float64(end.Sub(start))
+}
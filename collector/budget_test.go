@@ -0,0 +1,160 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	responseBudgetPendingCharges string = `{
+		"pending_charges": [
+			{
+				"description": "Load Balancer (my-loadbalancer)",
+				"product": "Load Balancer",
+				"units": 360,
+				"unit_type": "hours",
+				"unit_price": 0.015,
+				"total": 5
+			},
+			{
+				"description": "Cloud Compute (my-instance)",
+				"product": "Vultr Cloud Compute",
+				"units": 360,
+				"unit_type": "hours",
+				"unit_price": 0.05,
+				"total": 20
+			},
+			{
+				"description": "Cloud Compute (my-other-instance)",
+				"product": "Vultr Cloud Compute",
+				"units": 360,
+				"unit_type": "hours",
+				"unit_price": 0.05,
+				"total": 20
+			}
+		]
+	}`
+	// Burn rate depends upon the time of the test and is excluded
+	prometheusBudget string = `
+	# HELP test_budget_limit_usd Monthly budget (USD)
+	# TYPE test_budget_limit_usd gauge
+	test_budget_limit_usd{product="Load Balancer"} 20
+	test_budget_limit_usd{product="Vultr Cloud Compute"} 50
+	test_budget_limit_usd{product="total"} 100
+	# HELP test_budget_used_ratio Ratio of spend-to-date to the monthly budget
+	# TYPE test_budget_used_ratio gauge
+	test_budget_used_ratio{product="Load Balancer"} 0.25
+	test_budget_used_ratio{product="Vultr Cloud Compute"} 0.8
+	test_budget_used_ratio{product="total"} 0.45
+	`
+)
+
+func TestBudgetCollector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/billing/pending-charges", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := fmt.Fprint(w, responseBudgetPendingCharges); err != nil {
+			t.Errorf("unable to write response: %v", err)
+		}
+	})
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	budget := &Budget{
+		Total: 100,
+		Products: map[string]float64{
+			"Load Balancer":       20,
+			"Vultr Cloud Compute": 50,
+		},
+	}
+
+	collector := NewBudgetCollector(s, client, budget, log)
+
+	if err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(prometheusBudget),
+		"test_budget_limit_usd",
+		"test_budget_used_ratio",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
+
+func TestReadBudget(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		json string
+		ok   bool
+	}{
+		{
+			name: "Valid",
+			json: `{"total": 100, "products": {"Load Balancer": 20}}`,
+			ok:   true,
+		},
+		{
+			name: "Empty",
+			json: `{"total": 100, "products": {"": 20}}`,
+			ok:   false,
+		},
+		{
+			name: "Total",
+			json: `{"total": 100, "products": {"total": 20}}`,
+			ok:   false,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "budgets.json")
+			if err := os.WriteFile(path, []byte(test.json), 0o600); err != nil {
+				t.Fatalf("unable to write budget: %v", err)
+			}
+
+			_, err := ReadBudget(path)
+			if ok := err == nil; ok != test.ok {
+				t.Errorf("got %t, want %t (%v)", ok, test.ok, err)
+			}
+		})
+	}
+}
+
+func TestMonthElapsed(t *testing.T) {
+	for _, test := range []struct {
+		now  time.Time
+		want float64
+	}{
+		{
+			now:  time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+			want: 0,
+		},
+		{
+			now:  time.Date(2024, time.April, 16, 0, 0, 0, 0, time.UTC),
+			want: 0.5,
+		},
+		{
+			now:  time.Date(2024, time.February, 8, 6, 0, 0, 0, time.UTC),
+			want: 0.25,
+		},
+	} {
+		t.Run(test.now.Format(time.RFC3339), func(t *testing.T) {
+			if got := monthElapsed(test.now); got != test.want {
+				t.Errorf("got %f, want %f", got, test.want)
+			}
+		})
+	}
+}
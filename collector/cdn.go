@@ -0,0 +1,170 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+// CDNCollector represents CDN Pull and Push Zones
+type CDNCollector struct {
+	System        System
+	Client        *govultr.Client
+	Log           logr.Logger
+	Up            *prometheus.Desc
+	Requests      *prometheus.Desc
+	BytesIn       *prometheus.Desc
+	BytesOut      *prometheus.Desc
+	CacheSize     *prometheus.Desc
+	PacketsPerSec *prometheus.Desc
+}
+
+// NewCDNCollector creates a new CDNCollector
+func NewCDNCollector(s System, client *govultr.Client, log logr.Logger) *CDNCollector {
+	subsystem := "cdn"
+	labelKeys := []string{
+		"id",
+		"label",
+		"type",
+		"origin",
+		"regions",
+	}
+	return &CDNCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Up: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "zone_up"),
+			"CDN Zone",
+			append(labelKeys, "status"),
+			nil,
+		),
+		Requests: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "zone_requests"),
+			"Number of requests served by the CDN Zone",
+			labelKeys,
+			nil,
+		),
+		BytesIn: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "zone_in_bytes"),
+			"Ingress (bytes) of the CDN Zone",
+			labelKeys,
+			nil,
+		),
+		BytesOut: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "zone_out_bytes"),
+			"Egress (bytes) of the CDN Zone",
+			labelKeys,
+			nil,
+		),
+		CacheSize: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "zone_cache_size_bytes"),
+			"Size (bytes) of the CDN Zone's cache",
+			labelKeys,
+			nil,
+		),
+		PacketsPerSec: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "zone_packets_per_second"),
+			"Packets per second of the CDN Zone",
+			labelKeys,
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *CDNCollector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+
+	// ListPullZones and ListPushZones do not support pagination
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		zones, _, _, err := c.Client.CDN.ListPullZones(ctx)
+		if err != nil {
+			log.Error(err, "Unable to CDN.ListPullZones")
+			return
+		}
+		c.collectZones(ch, zones, "pull")
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		zones, _, _, err := c.Client.CDN.ListPushZones(ctx)
+		if err != nil {
+			log.Error(err, "Unable to CDN.ListPushZones")
+			return
+		}
+		c.collectZones(ch, zones, "push")
+	}()
+
+	wg.Wait()
+}
+
+// collectZones collects metrics for CDN Zones of a specific type (pull or push)
+func (c *CDNCollector) collectZones(ch chan<- prometheus.Metric, zones []govultr.CDNZone, zoneType string) {
+	for _, zone := range zones {
+		// Push Zones have no origin
+		origin := ""
+		if zone.OriginDomain != "" {
+			origin = fmt.Sprintf("%s://%s", zone.OriginScheme, zone.OriginDomain)
+		}
+
+		labelValues := []string{
+			zone.ID,
+			zone.Label,
+			zoneType,
+			origin,
+			strings.Join(zone.Regions, ","),
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			c.Up,
+			prometheus.CounterValue,
+			func(status string) (result float64) {
+				if status == "active" {
+					result = 1.0
+				}
+				return result
+			}(zone.Status),
+			append(labelValues, zone.Status)...,
+		)
+
+		// Map of metric to its value
+		for desc, value := range map[*prometheus.Desc]int{
+			c.Requests:      zone.Requests,
+			c.BytesIn:       zone.BytesIn,
+			c.BytesOut:      zone.BytesOut,
+			c.CacheSize:     zone.CacheSize,
+			c.PacketsPerSec: zone.PacketsPerSec,
+		} {
+			ch <- prometheus.MustNewConstMetric(
+				desc,
+				prometheus.GaugeValue,
+				float64(value),
+				labelValues...,
+			)
+		}
+	}
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *CDNCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Up
+	ch <- c.Requests
+	ch <- c.BytesIn
+	ch <- c.BytesOut
+	ch <- c.CacheSize
+	ch <- c.PacketsPerSec
+}
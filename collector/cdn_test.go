@@ -0,0 +1,122 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	responseCDNPullZones string = `{
+		"pull_zones": [
+			{
+				"id": "0f4b7c2a-9d1e-4b3a-8e6f-2c5d7a9b1e3f",
+				"status": "active",
+				"label": "my-pull-zone",
+				"origin_scheme": "https",
+				"origin_domain": "example.com",
+				"cache_size": 1048576,
+				"requests": 1000,
+				"in_bytes": 2048,
+				"out_bytes": 4096,
+				"packets_per_sec": 10,
+				"regions": ["north-america", "europe"]
+			}
+		],
+		"meta": {
+			"total": 1,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseCDNPushZones string = `{
+		"push_zones": [
+			{
+				"id": "7e2d9c4b-1a3f-4e5d-9b8c-6a4f2e1d3c5b",
+				"status": "pending",
+				"label": "my-push-zone",
+				"cache_size": 0,
+				"requests": 0,
+				"in_bytes": 0,
+				"out_bytes": 0,
+				"packets_per_sec": 0,
+				"regions": ["asia"]
+			}
+		],
+		"meta": {
+			"total": 1,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	prometheusCDN string = `
+	# HELP test_cdn_zone_cache_size_bytes Size (bytes) of the CDN Zone's cache
+	# TYPE test_cdn_zone_cache_size_bytes gauge
+	test_cdn_zone_cache_size_bytes{id="0f4b7c2a-9d1e-4b3a-8e6f-2c5d7a9b1e3f",label="my-pull-zone",origin="https://example.com",regions="north-america,europe",type="pull"} 1.048576e+06
+	test_cdn_zone_cache_size_bytes{id="7e2d9c4b-1a3f-4e5d-9b8c-6a4f2e1d3c5b",label="my-push-zone",origin="",regions="asia",type="push"} 0
+	# HELP test_cdn_zone_in_bytes Ingress (bytes) of the CDN Zone
+	# TYPE test_cdn_zone_in_bytes gauge
+	test_cdn_zone_in_bytes{id="0f4b7c2a-9d1e-4b3a-8e6f-2c5d7a9b1e3f",label="my-pull-zone",origin="https://example.com",regions="north-america,europe",type="pull"} 2048
+	test_cdn_zone_in_bytes{id="7e2d9c4b-1a3f-4e5d-9b8c-6a4f2e1d3c5b",label="my-push-zone",origin="",regions="asia",type="push"} 0
+	# HELP test_cdn_zone_out_bytes Egress (bytes) of the CDN Zone
+	# TYPE test_cdn_zone_out_bytes gauge
+	test_cdn_zone_out_bytes{id="0f4b7c2a-9d1e-4b3a-8e6f-2c5d7a9b1e3f",label="my-pull-zone",origin="https://example.com",regions="north-america,europe",type="pull"} 4096
+	test_cdn_zone_out_bytes{id="7e2d9c4b-1a3f-4e5d-9b8c-6a4f2e1d3c5b",label="my-push-zone",origin="",regions="asia",type="push"} 0
+	# HELP test_cdn_zone_packets_per_second Packets per second of the CDN Zone
+	# TYPE test_cdn_zone_packets_per_second gauge
+	test_cdn_zone_packets_per_second{id="0f4b7c2a-9d1e-4b3a-8e6f-2c5d7a9b1e3f",label="my-pull-zone",origin="https://example.com",regions="north-america,europe",type="pull"} 10
+	test_cdn_zone_packets_per_second{id="7e2d9c4b-1a3f-4e5d-9b8c-6a4f2e1d3c5b",label="my-push-zone",origin="",regions="asia",type="push"} 0
+	# HELP test_cdn_zone_requests Number of requests served by the CDN Zone
+	# TYPE test_cdn_zone_requests gauge
+	test_cdn_zone_requests{id="0f4b7c2a-9d1e-4b3a-8e6f-2c5d7a9b1e3f",label="my-pull-zone",origin="https://example.com",regions="north-america,europe",type="pull"} 1000
+	test_cdn_zone_requests{id="7e2d9c4b-1a3f-4e5d-9b8c-6a4f2e1d3c5b",label="my-push-zone",origin="",regions="asia",type="push"} 0
+	# HELP test_cdn_zone_up CDN Zone
+	# TYPE test_cdn_zone_up counter
+	test_cdn_zone_up{id="0f4b7c2a-9d1e-4b3a-8e6f-2c5d7a9b1e3f",label="my-pull-zone",origin="https://example.com",regions="north-america,europe",status="active",type="pull"} 1
+	test_cdn_zone_up{id="7e2d9c4b-1a3f-4e5d-9b8c-6a4f2e1d3c5b",label="my-push-zone",origin="",regions="asia",status="pending",type="push"} 0
+	`
+)
+
+func TestCDNCollector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for path, response := range map[string]string{
+		"/v2/cdns/pull-zones": responseCDNPullZones,
+		"/v2/cdns/push-zones": responseCDNPushZones,
+	} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if _, err := fmt.Fprint(w, response); err != nil {
+				t.Errorf("unable to write response: %v", err)
+			}
+		})
+	}
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	collector := NewCDNCollector(s, client, log)
+
+	if err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(prometheusCDN),
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
@@ -0,0 +1,238 @@
+package collector
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+// ContainerRegistryCollector represents Container Registries and their Repositories
+type ContainerRegistryCollector struct {
+	System         System
+	Client         *govultr.Client
+	Log            logr.Logger
+	Up             *prometheus.Desc
+	StorageUsed    *prometheus.Desc
+	StorageAllowed *prometheus.Desc
+	Repositories   *prometheus.Desc
+	Artifacts      *prometheus.Desc
+	Pulls          *prometheus.Desc
+}
+
+// NewContainerRegistryCollector creates a new ContainerRegistryCollector
+func NewContainerRegistryCollector(s System, client *govultr.Client, log logr.Logger) *ContainerRegistryCollector {
+	subsystem := "container_registry"
+	return &ContainerRegistryCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Up: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "up"),
+			"Container Registry",
+			[]string{
+				"id",
+				"name",
+				"region",
+				"public",
+			},
+			nil,
+		),
+		StorageUsed: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "storage_used_bytes"),
+			"Storage (bytes) used by the Container Registry",
+			[]string{
+				"id",
+				"name",
+				"region",
+			},
+			nil,
+		),
+		StorageAllowed: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "storage_allowed_bytes"),
+			"Storage (bytes) allowed by the Container Registry's plan",
+			[]string{
+				"id",
+				"name",
+				"region",
+			},
+			nil,
+		),
+		Repositories: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "repositories"),
+			"Number of Repositories in the Container Registry",
+			[]string{
+				"id",
+				"name",
+				"region",
+			},
+			nil,
+		),
+		Artifacts: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "repository_artifacts"),
+			"Number of artifacts in the Repository",
+			[]string{
+				"id",
+				"name",
+				"repository",
+			},
+			nil,
+		),
+		Pulls: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "repository_pulls"),
+			"Number of pulls of the Repository",
+			[]string{
+				"id",
+				"name",
+				"repository",
+			},
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *ContainerRegistryCollector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx := context.Background()
+
+	// Get all registries across all pages
+	var allRegistries []govultr.ContainerRegistry
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		registries, meta, _, err := c.Client.ContainerRegistry.List(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to ContainerRegistry.List")
+			return
+		}
+
+		allRegistries = append(allRegistries, registries...)
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	// Enumerate the registries
+	var wg sync.WaitGroup
+	for _, registry := range allRegistries {
+		wg.Add(1)
+		go func(registry govultr.ContainerRegistry) {
+			defer wg.Done()
+
+			region := registry.Metadata.Region.Name
+
+			ch <- prometheus.MustNewConstMetric(
+				c.Up,
+				prometheus.CounterValue,
+				1.0,
+				[]string{
+					registry.ID,
+					registry.Name,
+					region,
+					strconv.FormatBool(registry.Public),
+				}...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.StorageUsed,
+				prometheus.GaugeValue,
+				float64(registry.Storage.Used.Bytes),
+				[]string{
+					registry.ID,
+					registry.Name,
+					region,
+				}...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.StorageAllowed,
+				prometheus.GaugeValue,
+				float64(registry.Storage.Allowed.Bytes),
+				[]string{
+					registry.ID,
+					registry.Name,
+					region,
+				}...,
+			)
+
+			// Get all the registry's repositories across all pages
+			var allRepos []govultr.ContainerRegistryRepo
+			options := &govultr.ListOptions{
+				PerPage: 100,
+			}
+
+			for {
+				repos, meta, _, err := c.Client.ContainerRegistry.ListRepositories(ctx, registry.ID, options)
+				if err != nil {
+					log.Error(err, "Unable to ContainerRegistry.ListRepositories",
+						"id", registry.ID,
+					)
+					return
+				}
+
+				allRepos = append(allRepos, repos...)
+
+				// If we've received all items or there's no next page, break
+				if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+					break
+				}
+
+				// Move to next page
+				options.Cursor = meta.Links.Next
+			}
+
+			ch <- prometheus.MustNewConstMetric(
+				c.Repositories,
+				prometheus.GaugeValue,
+				float64(len(allRepos)),
+				[]string{
+					registry.ID,
+					registry.Name,
+					region,
+				}...,
+			)
+			for _, repo := range allRepos {
+				ch <- prometheus.MustNewConstMetric(
+					c.Artifacts,
+					prometheus.GaugeValue,
+					float64(repo.ArtifactCount),
+					[]string{
+						registry.ID,
+						registry.Name,
+						repo.Name,
+					}...,
+				)
+				ch <- prometheus.MustNewConstMetric(
+					c.Pulls,
+					prometheus.CounterValue,
+					float64(repo.PullCount),
+					[]string{
+						registry.ID,
+						registry.Name,
+						repo.Name,
+					}...,
+				)
+			}
+		}(registry)
+	}
+	wg.Wait()
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *ContainerRegistryCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Up
+	ch <- c.StorageUsed
+	ch <- c.StorageAllowed
+	ch <- c.Repositories
+	ch <- c.Artifacts
+	ch <- c.Pulls
+}
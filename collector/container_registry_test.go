@@ -0,0 +1,159 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	responseContainerRegistries string = `{
+		"registries": [
+			{
+				"id": "4dcdd5b4-0b7c-4bbc-a3b3-6f3a3a4b9c01",
+				"name": "myregistry",
+				"public": false,
+				"storage": {
+					"used": {
+						"bytes": 1073741824
+					},
+					"allowed": {
+						"bytes": 21474836480
+					}
+				},
+				"metadata": {
+					"region": {
+						"id": 1,
+						"name": "sjc"
+					}
+				}
+			},
+			{
+				"id": "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+				"name": "myemptyregistry",
+				"public": true,
+				"storage": {
+					"used": {
+						"bytes": 0
+					},
+					"allowed": {
+						"bytes": 21474836480
+					}
+				},
+				"metadata": {
+					"region": {
+						"id": 2,
+						"name": "ams"
+					}
+				}
+			}
+		],
+		"meta": {
+			"total": 2,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseContainerRegistryRepositories string = `{
+		"repositories": [
+			{
+				"name": "myregistry/frontend",
+				"image": "frontend",
+				"pull_count": 120,
+				"artifact_count": 3
+			},
+			{
+				"name": "myregistry/backend",
+				"image": "backend",
+				"pull_count": 0,
+				"artifact_count": 1
+			}
+		],
+		"meta": {
+			"total": 2,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseContainerRegistryRepositoriesNone string = `{
+		"repositories": [],
+		"meta": {
+			"total": 0,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	prometheusContainerRegistry string = `
+	# HELP test_container_registry_repositories Number of Repositories in the Container Registry
+	# TYPE test_container_registry_repositories gauge
+	test_container_registry_repositories{id="4dcdd5b4-0b7c-4bbc-a3b3-6f3a3a4b9c01",name="myregistry",region="sjc"} 2
+	test_container_registry_repositories{id="9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",name="myemptyregistry",region="ams"} 0
+	# HELP test_container_registry_repository_artifacts Number of artifacts in the Repository
+	# TYPE test_container_registry_repository_artifacts gauge
+	test_container_registry_repository_artifacts{id="4dcdd5b4-0b7c-4bbc-a3b3-6f3a3a4b9c01",name="myregistry",repository="myregistry/backend"} 1
+	test_container_registry_repository_artifacts{id="4dcdd5b4-0b7c-4bbc-a3b3-6f3a3a4b9c01",name="myregistry",repository="myregistry/frontend"} 3
+	# HELP test_container_registry_repository_pulls Number of pulls of the Repository
+	# TYPE test_container_registry_repository_pulls counter
+	test_container_registry_repository_pulls{id="4dcdd5b4-0b7c-4bbc-a3b3-6f3a3a4b9c01",name="myregistry",repository="myregistry/backend"} 0
+	test_container_registry_repository_pulls{id="4dcdd5b4-0b7c-4bbc-a3b3-6f3a3a4b9c01",name="myregistry",repository="myregistry/frontend"} 120
+	# HELP test_container_registry_storage_allowed_bytes Storage (bytes) allowed by the Container Registry's plan
+	# TYPE test_container_registry_storage_allowed_bytes gauge
+	test_container_registry_storage_allowed_bytes{id="4dcdd5b4-0b7c-4bbc-a3b3-6f3a3a4b9c01",name="myregistry",region="sjc"} 2.147483648e+10
+	test_container_registry_storage_allowed_bytes{id="9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",name="myemptyregistry",region="ams"} 2.147483648e+10
+	# HELP test_container_registry_storage_used_bytes Storage (bytes) used by the Container Registry
+	# TYPE test_container_registry_storage_used_bytes gauge
+	test_container_registry_storage_used_bytes{id="4dcdd5b4-0b7c-4bbc-a3b3-6f3a3a4b9c01",name="myregistry",region="sjc"} 1.073741824e+09
+	test_container_registry_storage_used_bytes{id="9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",name="myemptyregistry",region="ams"} 0
+	# HELP test_container_registry_up Container Registry
+	# TYPE test_container_registry_up counter
+	test_container_registry_up{id="4dcdd5b4-0b7c-4bbc-a3b3-6f3a3a4b9c01",name="myregistry",public="false",region="sjc"} 1
+	test_container_registry_up{id="9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",name="myemptyregistry",public="true",region="ams"} 1
+	`
+)
+
+func TestContainerRegistryCollector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for path, response := range map[string]string{
+		"/v2/registries": responseContainerRegistries,
+		"/v2/registry/4dcdd5b4-0b7c-4bbc-a3b3-6f3a3a4b9c01/repositories": responseContainerRegistryRepositories,
+		"/v2/registry/9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d/repositories": responseContainerRegistryRepositoriesNone,
+	} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if _, err := fmt.Fprint(w, response); err != nil {
+				t.Errorf("unable to write response: %v", err)
+			}
+		})
+	}
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	collector := NewContainerRegistryCollector(s, client, log)
+
+	if err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(prometheusContainerRegistry),
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
@@ -0,0 +1,415 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+// DNSCollector represents DNS Domains and their Records
+type DNSCollector struct {
+	System System
+	Client *govultr.Client
+	Log    logr.Logger
+
+	// CheckDangling enables checking A and AAAA records against the account's IP addresses
+	CheckDangling bool
+
+	Domains  *prometheus.Desc
+	Up       *prometheus.Desc
+	DNSSec   *prometheus.Desc
+	Records  *prometheus.Desc
+	SOA      *prometheus.Desc
+	Dangling *prometheus.Desc
+}
+
+// NewDNSCollector creates a new DNSCollector
+func NewDNSCollector(s System, client *govultr.Client, checkDangling bool, log logr.Logger) *DNSCollector {
+	subsystem := "dns"
+	return &DNSCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+
+		CheckDangling: checkDangling,
+
+		Domains: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "domains"),
+			"Number of Domains",
+			nil,
+			nil,
+		),
+		Up: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "domain_up"),
+			"Domain",
+			[]string{
+				"domain",
+			},
+			nil,
+		),
+		DNSSec: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "domain_dnssec_enabled"),
+			"Whether DNSSEC is enabled for the Domain (1=enabled)",
+			[]string{
+				"domain",
+			},
+			nil,
+		),
+		Records: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "domain_records"),
+			"Number of Records in the Domain",
+			[]string{
+				"domain",
+				"type",
+			},
+			nil,
+		),
+		SOA: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "domain_soa_info"),
+			"A metric with a constant '1' value labeled by the Domain's SOA primary nameserver and email",
+			[]string{
+				"domain",
+				"nsprimary",
+				"email",
+			},
+			nil,
+		),
+		Dangling: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "record_dangling"),
+			"A or AAAA Record whose address is not owned by any Instance, Bare Metal server, Load Balancer or Reserved IP in the account",
+			[]string{
+				"domain",
+				"id",
+				"name",
+				"type",
+				"data",
+			},
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *DNSCollector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx := context.Background()
+
+	// Get all domains across all pages
+	var allDomains []govultr.Domain
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		domains, meta, _, err := c.Client.Domain.List(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to Domain.List")
+			return
+		}
+
+		allDomains = append(allDomains, domains...)
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	ch <- prometheus.MustNewConstMetric(
+		c.Domains,
+		prometheus.GaugeValue,
+		float64(len(allDomains)),
+	)
+
+	var owned *ownedAddresses
+	if c.CheckDangling {
+		var err error
+		owned, err = c.ownedAddresses(ctx)
+		if err != nil {
+			// Rather than report every record as dangling, skip the check
+			log.Error(err, "Unable to determine the account's addresses")
+		}
+	}
+
+	// Enumerate the domains
+	var wg sync.WaitGroup
+	for _, domain := range allDomains {
+		wg.Add(1)
+		go func(domain govultr.Domain) {
+			defer wg.Done()
+
+			ch <- prometheus.MustNewConstMetric(
+				c.Up,
+				prometheus.CounterValue,
+				1.0,
+				[]string{
+					domain.Domain,
+				}...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.DNSSec,
+				prometheus.GaugeValue,
+				func(dnssec string) (result float64) {
+					if dnssec == "enabled" {
+						result = 1.0
+					}
+					return result
+				}(domain.DNSSec),
+				[]string{
+					domain.Domain,
+				}...,
+			)
+
+			if soa, _, err := c.Client.Domain.GetSoa(ctx, domain.Domain); err != nil {
+				log.Error(err, "Unable to Domain.GetSoa",
+					"domain", domain.Domain,
+				)
+			} else {
+				ch <- prometheus.MustNewConstMetric(
+					c.SOA,
+					prometheus.GaugeValue,
+					1.0,
+					[]string{
+						domain.Domain,
+						soa.NSPrimary,
+						soa.Email,
+					}...,
+				)
+			}
+
+			// Get all the domain's records across all pages
+			var allRecords []govultr.DomainRecord
+			options := &govultr.ListOptions{
+				PerPage: 100,
+			}
+
+			for {
+				records, meta, _, err := c.Client.DomainRecord.List(ctx, domain.Domain, options)
+				if err != nil {
+					log.Error(err, "Unable to DomainRecord.List",
+						"domain", domain.Domain,
+					)
+					return
+				}
+
+				allRecords = append(allRecords, records...)
+
+				// If we've received all items or there's no next page, break
+				if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+					break
+				}
+
+				// Move to next page
+				options.Cursor = meta.Links.Next
+			}
+
+			// Count records by type
+			counts := make(map[string]int)
+			for _, record := range allRecords {
+				counts[record.Type]++
+			}
+			for recordType, count := range counts {
+				ch <- prometheus.MustNewConstMetric(
+					c.Records,
+					prometheus.GaugeValue,
+					float64(count),
+					[]string{
+						domain.Domain,
+						recordType,
+					}...,
+				)
+			}
+
+			if owned == nil {
+				return
+			}
+			for _, record := range allRecords {
+				if record.Type != "A" && record.Type != "AAAA" {
+					continue
+				}
+				addr, err := netip.ParseAddr(record.Data)
+				if err != nil {
+					continue
+				}
+				if owned.Contains(addr) {
+					continue
+				}
+				ch <- prometheus.MustNewConstMetric(
+					c.Dangling,
+					prometheus.GaugeValue,
+					1.0,
+					[]string{
+						domain.Domain,
+						record.ID,
+						record.Name,
+						record.Type,
+						record.Data,
+					}...,
+				)
+			}
+		}(domain)
+	}
+	wg.Wait()
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *DNSCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Domains
+	ch <- c.Up
+	ch <- c.DNSSec
+	ch <- c.Records
+	ch <- c.SOA
+	ch <- c.Dangling
+}
+
+// ownedAddresses represents the IP addresses and subnets owned by the account
+type ownedAddresses struct {
+	addrs    map[netip.Addr]bool
+	prefixes []netip.Prefix
+}
+
+// addAddr adds an address (if valid) to the owned addresses
+func (o *ownedAddresses) addAddr(s string) {
+	if addr, err := netip.ParseAddr(s); err == nil {
+		o.addrs[addr.Unmap()] = true
+	}
+}
+
+// addPrefix adds a subnet (if valid) to the owned addresses
+func (o *ownedAddresses) addPrefix(subnet string, size int) {
+	if subnet == "" || size == 0 {
+		return
+	}
+	if prefix, err := netip.ParsePrefix(fmt.Sprintf("%s/%d", subnet, size)); err == nil {
+		o.prefixes = append(o.prefixes, prefix.Masked())
+	}
+}
+
+// Contains returns true if the address is owned
+func (o *ownedAddresses) Contains(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if o.addrs[addr] {
+		return true
+	}
+	for _, prefix := range o.prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ownedAddresses returns the addresses of the account's Instances, Bare Metal servers, Load Balancers and Reserved IPs
+func (c *DNSCollector) ownedAddresses(ctx context.Context) (*ownedAddresses, error) {
+	owned := &ownedAddresses{
+		addrs: make(map[netip.Addr]bool),
+	}
+
+	// Instances
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+	for {
+		instances, meta, _, err := c.Client.Instance.List(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, instance := range instances {
+			owned.addAddr(instance.MainIP)
+			owned.addAddr(instance.V6MainIP)
+			owned.addPrefix(instance.V6Network, instance.V6NetworkSize)
+		}
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	// Bare Metal servers
+	options = &govultr.ListOptions{
+		PerPage: 100,
+	}
+	for {
+		servers, meta, _, err := c.Client.BareMetalServer.List(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, server := range servers {
+			owned.addAddr(server.MainIP)
+			owned.addAddr(server.V6MainIP)
+			owned.addPrefix(server.V6Network, server.V6NetworkSize)
+		}
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	// Load Balancers
+	options = &govultr.ListOptions{
+		PerPage: 100,
+	}
+	for {
+		loadbalancers, meta, _, err := c.Client.LoadBalancer.List(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, lb := range loadbalancers {
+			owned.addAddr(lb.IPV4)
+			owned.addAddr(lb.IPV6)
+		}
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	// Reserved IPs
+	options = &govultr.ListOptions{
+		PerPage: 100,
+	}
+	for {
+		ips, meta, _, err := c.Client.ReservedIP.List(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ip := range ips {
+			owned.addAddr(ip.Subnet)
+			owned.addPrefix(ip.Subnet, ip.SubnetSize)
+		}
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	return owned, nil
+}
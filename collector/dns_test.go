@@ -0,0 +1,35 @@
+package collector
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestOwnedAddresses(t *testing.T) {
+	owned := &ownedAddresses{
+		addrs: make(map[netip.Addr]bool),
+	}
+	owned.addAddr("192.0.2.1")
+	owned.addAddr("2001:db8::1")
+	owned.addAddr("")
+	owned.addPrefix("198.51.100.0", 24)
+	owned.addPrefix("2001:db8:1::", 64)
+	owned.addPrefix("", 0)
+
+	for s, want := range map[string]bool{
+		"192.0.2.1":        true,
+		"192.0.2.2":        false,
+		"::ffff:192.0.2.1": true,
+		"2001:db8::1":      true,
+		"198.51.100.42":    true,
+		"203.0.113.1":      false,
+		"2001:db8:1::abcd": true,
+		"2001:db8:2::abcd": false,
+	} {
+		t.Run(s, func(t *testing.T) {
+			if got := owned.Contains(netip.MustParseAddr(s)); got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
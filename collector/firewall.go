@@ -0,0 +1,279 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+var (
+	// sensitivePorts are ports that should not be exposed to the Internet
+	// SSH, RDP, PostgreSQL, MySQL and Redis
+	sensitivePorts = []int{22, 3389, 5432, 3306, 6379}
+)
+
+// FirewallCollector represents Firewall Groups and their Rules
+type FirewallCollector struct {
+	System    System
+	Client    *govultr.Client
+	Log       logr.Logger
+	Up        *prometheus.Desc
+	Instances *prometheus.Desc
+	Rules     *prometheus.Desc
+	MaxRules  *prometheus.Desc
+	Exposed   *prometheus.Desc
+}
+
+// NewFirewallCollector creates a new FirewallCollector
+func NewFirewallCollector(s System, client *govultr.Client, log logr.Logger) *FirewallCollector {
+	subsystem := "firewall"
+	return &FirewallCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Up: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "group_up"),
+			"Firewall Group",
+			[]string{
+				"id",
+				"description",
+			},
+			nil,
+		),
+		Instances: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "group_instances"),
+			"Number of Instances using the Firewall Group",
+			[]string{
+				"id",
+				"description",
+			},
+			nil,
+		),
+		Rules: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "group_rules"),
+			"Number of Firewall Rules in the Firewall Group",
+			[]string{
+				"id",
+				"description",
+			},
+			nil,
+		),
+		MaxRules: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "group_max_rules"),
+			"Maximum number of Firewall Rules permitted in the Firewall Group",
+			[]string{
+				"id",
+				"description",
+			},
+			nil,
+		),
+		Exposed: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "rule_exposed"),
+			"Whether the Firewall Rule exposes a sensitive port to the Internet (1=exposed)",
+			[]string{
+				"group_id",
+				"description",
+				"rule_id",
+				"ip_type",
+				"protocol",
+				"port",
+				"subnet",
+			},
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *FirewallCollector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx := context.Background()
+
+	// Get all firewall groups across all pages
+	var allGroups []govultr.FirewallGroup
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		groups, meta, _, err := c.Client.FirewallGroup.List(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to FirewallGroup.List")
+			return
+		}
+
+		allGroups = append(allGroups, groups...)
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	// Enumerate the groups
+	var wg sync.WaitGroup
+	for _, group := range allGroups {
+		wg.Add(1)
+		go func(group govultr.FirewallGroup) {
+			defer wg.Done()
+
+			ch <- prometheus.MustNewConstMetric(
+				c.Up,
+				prometheus.CounterValue,
+				1.0,
+				[]string{
+					group.ID,
+					group.Description,
+				}...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.Instances,
+				prometheus.GaugeValue,
+				float64(group.InstanceCount),
+				[]string{
+					group.ID,
+					group.Description,
+				}...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.Rules,
+				prometheus.GaugeValue,
+				float64(group.RuleCount),
+				[]string{
+					group.ID,
+					group.Description,
+				}...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.MaxRules,
+				prometheus.GaugeValue,
+				float64(group.MaxRuleCount),
+				[]string{
+					group.ID,
+					group.Description,
+				}...,
+			)
+
+			// Get all the group's rules across all pages
+			var allRules []govultr.FirewallRule
+			options := &govultr.ListOptions{
+				PerPage: 100,
+			}
+
+			for {
+				rules, meta, _, err := c.Client.FirewallRule.List(ctx, group.ID, options)
+				if err != nil {
+					log.Error(err, "Unable to FirewallRule.List",
+						"id", group.ID,
+					)
+					return
+				}
+
+				allRules = append(allRules, rules...)
+
+				// If we've received all items or there's no next page, break
+				if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+					break
+				}
+
+				// Move to next page
+				options.Cursor = meta.Links.Next
+			}
+
+			for _, rule := range allRules {
+				ch <- prometheus.MustNewConstMetric(
+					c.Exposed,
+					prometheus.GaugeValue,
+					func(exposed bool) (result float64) {
+						if exposed {
+							result = 1.0
+						}
+						return result
+					}(exposesSensitivePort(rule)),
+					[]string{
+						group.ID,
+						group.Description,
+						strconv.Itoa(rule.ID),
+						rule.IPType,
+						rule.Protocol,
+						rule.Port,
+						fmt.Sprintf("%s/%d", rule.Subnet, rule.SubnetSize),
+					}...,
+				)
+			}
+		}(group)
+	}
+	wg.Wait()
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *FirewallCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Up
+	ch <- c.Instances
+	ch <- c.Rules
+	ch <- c.MaxRules
+	ch <- c.Exposed
+}
+
+// exposesSensitivePort returns true if the rule accepts traffic from anywhere (0.0.0.0/0 or ::/0)
+// on a port (or a range of ports) that includes any of the sensitive ports
+func exposesSensitivePort(rule govultr.FirewallRule) bool {
+	if rule.Action != "" && rule.Action != "accept" {
+		return false
+	}
+
+	// Rules with a source (e.g. "cloudflare") only accept traffic from that source
+	if rule.Source != "" {
+		return false
+	}
+
+	// Only TCP and UDP rules have ports
+	protocol := strings.ToLower(rule.Protocol)
+	if protocol != "tcp" && protocol != "udp" {
+		return false
+	}
+
+	if rule.SubnetSize != 0 {
+		return false
+	}
+	switch rule.Subnet {
+	case "0.0.0.0", "::":
+	default:
+		return false
+	}
+
+	// An empty port matches all ports
+	if rule.Port == "" {
+		return true
+	}
+
+	// Ports are either a single port (e.g. "22") or a range (e.g. "8000:9000")
+	lo, hi, found := strings.Cut(rule.Port, ":")
+	if !found {
+		hi = lo
+	}
+	from, err := strconv.Atoi(strings.TrimSpace(lo))
+	if err != nil {
+		return false
+	}
+	to, err := strconv.Atoi(strings.TrimSpace(hi))
+	if err != nil {
+		return false
+	}
+
+	for _, port := range sensitivePorts {
+		if port >= from && port <= to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package collector
+
+import (
+	"testing"
+
+	"github.com/vultr/govultr/v3"
+)
+
+func TestExposesSensitivePort(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		rule govultr.FirewallRule
+		want bool
+	}{
+		{
+			name: "SSH from anywhere (IPv4)",
+			rule: govultr.FirewallRule{Action: "accept", IPType: "v4", Protocol: "tcp", Port: "22", Subnet: "0.0.0.0", SubnetSize: 0},
+			want: true,
+		},
+		{
+			name: "PostgreSQL from anywhere (IPv6)",
+			rule: govultr.FirewallRule{Action: "accept", IPType: "v6", Protocol: "tcp", Port: "5432", Subnet: "::", SubnetSize: 0},
+			want: true,
+		},
+		{
+			name: "Range including RDP",
+			rule: govultr.FirewallRule{Action: "accept", IPType: "v4", Protocol: "tcp", Port: "3000:4000", Subnet: "0.0.0.0", SubnetSize: 0},
+			want: true,
+		},
+		{
+			name: "HTTPS from anywhere",
+			rule: govultr.FirewallRule{Action: "accept", IPType: "v4", Protocol: "tcp", Port: "443", Subnet: "0.0.0.0", SubnetSize: 0},
+			want: false,
+		},
+		{
+			name: "SSH from private subnet",
+			rule: govultr.FirewallRule{Action: "accept", IPType: "v4", Protocol: "tcp", Port: "22", Subnet: "10.0.0.0", SubnetSize: 8},
+			want: false,
+		},
+		{
+			name: "SSH from Cloudflare",
+			rule: govultr.FirewallRule{Action: "accept", IPType: "v4", Protocol: "tcp", Port: "22", Subnet: "0.0.0.0", SubnetSize: 0, Source: "cloudflare"},
+			want: false,
+		},
+		{
+			name: "ICMP from anywhere",
+			rule: govultr.FirewallRule{Action: "accept", IPType: "v4", Protocol: "icmp", Subnet: "0.0.0.0", SubnetSize: 0},
+			want: false,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if got := exposesSensitivePort(test.rule); got != test.want {
+				t.Errorf("got %t, want %t", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,400 @@
+package collector
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+const (
+	// hoursPerMonth is the average number of hours in a month
+	// It is used to convert hourly prices into monthly costs
+	hoursPerMonth float64 = 730
+)
+
+// IdleResourceCollector represents resources that are billed but unused
+// Its metric estimates the monthly cost of each such resource
+type IdleResourceCollector struct {
+	System System
+	Client *govultr.Client
+	Log    logr.Logger
+	Cost   *prometheus.Desc
+}
+
+// NewIdleResourceCollector creates a new IdleResourceCollector
+func NewIdleResourceCollector(s System, client *govultr.Client, log logr.Logger) *IdleResourceCollector {
+	subsystem := "idle_resource"
+	return &IdleResourceCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Cost: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "monthly_cost_usd"),
+			"Estimated monthly cost (USD) of resources that are billed but idle",
+			[]string{
+				"kind",
+				"id",
+				"label",
+				"region",
+			},
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *IdleResourceCollector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx := context.Background()
+
+	// Plans are used to price Instances and Kubernetes Node Pools
+	plans, err := c.planCosts(ctx)
+	if err != nil {
+		log.Error(err, "Unable to Plan.List")
+	}
+
+	// Pending charges are used to price Reserved IPs and Load Balancers
+	// The Vultr API does not include the price of these resources
+	charges, err := listPendingCharges(ctx, c.Client)
+	if err != nil {
+		log.Error(err, "Unable to Billing.ListPendingCharges")
+	}
+
+	var wg sync.WaitGroup
+	for _, collect := range []func(){
+		func() { c.collectInstances(ctx, ch, plans) },
+		func() { c.collectBlockStorage(ctx, ch) },
+		func() { c.collectReservedIPs(ctx, ch, charges) },
+		func() { c.collectLoadBalancers(ctx, ch, charges) },
+		func() { c.collectNodePools(ctx, ch, plans) },
+	} {
+		wg.Add(1)
+		go func(collect func()) {
+			defer wg.Done()
+			collect()
+		}(collect)
+	}
+	wg.Wait()
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *IdleResourceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Cost
+}
+
+// planCosts returns a map of Plan ID to the Plan's monthly cost
+func (c *IdleResourceCollector) planCosts(ctx context.Context) (map[string]float64, error) {
+	costs := make(map[string]float64)
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		plans, meta, _, err := c.Client.Plan.List(ctx, "", options)
+		if err != nil {
+			return costs, err
+		}
+
+		for _, plan := range plans {
+			costs[plan.ID] = float64(plan.MonthlyCost)
+		}
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	return costs, nil
+}
+
+// collectInstances collects Instances that are stopped
+// Stopped Instances continue to be billed
+func (c *IdleResourceCollector) collectInstances(ctx context.Context, ch chan<- prometheus.Metric, plans map[string]float64) {
+	log := c.Log.WithName("collectInstances")
+
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		instances, meta, _, err := c.Client.Instance.List(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to Instance.List")
+			return
+		}
+
+		for _, instance := range instances {
+			if instance.PowerStatus != "stopped" {
+				continue
+			}
+
+			cost, ok := plans[instance.Plan]
+			if !ok {
+				log.Info("Unable to find Plan",
+					"id", instance.ID,
+					"plan", instance.Plan,
+				)
+				continue
+			}
+			ch <- prometheus.MustNewConstMetric(
+				c.Cost,
+				prometheus.GaugeValue,
+				cost,
+				[]string{
+					"instance",
+					instance.ID,
+					instance.Label,
+					instance.Region,
+				}...,
+			)
+		}
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+}
+
+// collectBlockStorage collects Block Storage that is not attached to an Instance
+func (c *IdleResourceCollector) collectBlockStorage(ctx context.Context, ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("collectBlockStorage")
+
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		blocks, meta, _, err := c.Client.BlockStorage.List(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to BlockStorage.List")
+			return
+		}
+
+		for _, block := range blocks {
+			if block.AttachedToInstance != "" {
+				continue
+			}
+
+			ch <- prometheus.MustNewConstMetric(
+				c.Cost,
+				prometheus.GaugeValue,
+				float64(block.Cost),
+				[]string{
+					"block_storage",
+					block.ID,
+					block.Label,
+					block.Region,
+				}...,
+			)
+		}
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+}
+
+// collectReservedIPs collects Reserved IPs that are not attached to an Instance
+func (c *IdleResourceCollector) collectReservedIPs(ctx context.Context, ch chan<- prometheus.Metric, charges []govultr.InvoiceItem) {
+	log := c.Log.WithName("collectReservedIPs")
+
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		ips, meta, _, err := c.Client.ReservedIP.List(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to ReservedIP.List")
+			return
+		}
+
+		for _, ip := range ips {
+			if ip.InstanceID != "" {
+				continue
+			}
+
+			// Reserved IP charges are described by their address (e.g. "Reserved IP (192.0.2.1)")
+			cost, ok := pendingMonthlyCost(charges, "Reserved IP", ip.Subnet)
+			if !ok {
+				log.Info("Unable to find pending charge",
+					"id", ip.ID,
+				)
+				continue
+			}
+			ch <- prometheus.MustNewConstMetric(
+				c.Cost,
+				prometheus.GaugeValue,
+				cost,
+				[]string{
+					"reserved_ip",
+					ip.ID,
+					ip.Label,
+					ip.Region,
+				}...,
+			)
+		}
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+}
+
+// collectLoadBalancers collects Load Balancers that have no Instances
+func (c *IdleResourceCollector) collectLoadBalancers(ctx context.Context, ch chan<- prometheus.Metric, charges []govultr.InvoiceItem) {
+	log := c.Log.WithName("collectLoadBalancers")
+
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		loadbalancers, meta, _, err := c.Client.LoadBalancer.List(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to LoadBalancer.List")
+			return
+		}
+
+		for _, lb := range loadbalancers {
+			if len(lb.Instances) != 0 {
+				continue
+			}
+
+			// Load Balancer charges are described by their label (e.g. "Load Balancer (my-loadbalancer)")
+			cost, ok := pendingMonthlyCost(charges, "Load Balancer", lb.Label)
+			if !ok {
+				log.Info("Unable to find pending charge",
+					"id", lb.ID,
+				)
+				continue
+			}
+			ch <- prometheus.MustNewConstMetric(
+				c.Cost,
+				prometheus.GaugeValue,
+				cost,
+				[]string{
+					"load_balancer",
+					lb.ID,
+					lb.Label,
+					lb.Region,
+				}...,
+			)
+		}
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+}
+
+// collectNodePools collects Kubernetes Node Pools that have Nodes none of which are active
+// The Vultr API does not report workloads so Node Pools with (only) inactive Nodes are considered empty
+// This includes Node Pools whose Nodes are all pending (e.g. while being created)
+func (c *IdleResourceCollector) collectNodePools(ctx context.Context, ch chan<- prometheus.Metric, plans map[string]float64) {
+	log := c.Log.WithName("collectNodePools")
+
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		clusters, meta, _, err := c.Client.Kubernetes.ListClusters(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to Kubernetes.ListClusters")
+			return
+		}
+
+		for _, cluster := range clusters {
+			for _, nodepool := range cluster.NodePools {
+				if len(nodepool.Nodes) == 0 || hasActiveNode(nodepool.Nodes) {
+					continue
+				}
+
+				cost, ok := plans[nodepool.Plan]
+				if !ok {
+					log.Info("Unable to find Plan",
+						"id", nodepool.ID,
+						"plan", nodepool.Plan,
+					)
+					continue
+				}
+				ch <- prometheus.MustNewConstMetric(
+					c.Cost,
+					prometheus.GaugeValue,
+					cost*float64(len(nodepool.Nodes)),
+					[]string{
+						"kubernetes_node_pool",
+						nodepool.ID,
+						nodepool.Label,
+						cluster.Region,
+					}...,
+				)
+			}
+		}
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+}
+
+// hasActiveNode returns true if any of the Nodes is active
+func hasActiveNode(nodes []govultr.Node) bool {
+	for _, node := range nodes {
+		if node.Status == "active" {
+			return true
+		}
+	}
+	return false
+}
+
+// pendingMonthlyCost estimates the monthly cost of the (first) pending charge for product whose description names name
+// The name must match the name in the description's parentheses exactly (e.g. "Reserved IP (192.0.2.1)")
+// Hourly charges are converted to monthly costs using the unit price
+func pendingMonthlyCost(charges []govultr.InvoiceItem, product, name string) (float64, bool) {
+	if name == "" {
+		return 0, false
+	}
+
+	for _, charge := range charges {
+		if !strings.EqualFold(charge.Product, product) || descriptionName(charge.Description) != name {
+			continue
+		}
+
+		if strings.HasPrefix(charge.UnitType, "hour") {
+			return float64(charge.UnitPrice) * hoursPerMonth, true
+		}
+		return float64(charge.UnitPrice), true
+	}
+
+	return 0, false
+}
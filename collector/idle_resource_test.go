@@ -0,0 +1,349 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/vultr/govultr/v3"
+)
+
+var (
+	responseIdlePlans string = `{
+		"plans": [
+			{
+				"id": "vc2-1c-1gb",
+				"monthly_cost": 5,
+				"type": "vc2"
+			},
+			{
+				"id": "vc2-2c-4gb",
+				"monthly_cost": 20,
+				"type": "vc2"
+			}
+		],
+		"meta": {
+			"total": 2,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseIdlePendingCharges string = `{
+		"pending_charges": [
+			{
+				"description": "Load Balancer (my-loadbalancer)",
+				"product": "Load Balancer",
+				"units": 10,
+				"unit_type": "hours",
+				"unit_price": 0.015625,
+				"total": 0.15625
+			},
+			{
+				"description": "Cloud Compute (192.0.2.1)",
+				"product": "Cloud Compute",
+				"units": 10,
+				"unit_type": "hours",
+				"unit_price": 0.03125,
+				"total": 0.3125
+			},
+			{
+				"description": "Reserved IP (192.0.2.10)",
+				"product": "Reserved IP",
+				"units": 10,
+				"unit_type": "hours",
+				"unit_price": 0.0078125,
+				"total": 0.078125
+			},
+			{
+				"description": "Reserved IP (192.0.2.1)",
+				"product": "Reserved IP",
+				"units": 10,
+				"unit_type": "hours",
+				"unit_price": 0.00390625,
+				"total": 0.0390625
+			}
+		]
+	}`
+	responseIdleInstances string = `{
+		"instances": [
+			{
+				"id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+				"label": "running",
+				"region": "ewr",
+				"plan": "vc2-1c-1gb",
+				"power_status": "running"
+			},
+			{
+				"id": "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
+				"label": "stopped",
+				"region": "ewr",
+				"plan": "vc2-2c-4gb",
+				"power_status": "stopped"
+			}
+		],
+		"meta": {
+			"total": 2,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseIdleBlocks string = `{
+		"blocks": [
+			{
+				"id": "e0a05b4a-8b49-4b3c-9a1a-7b1d6d1e7c2f",
+				"label": "attached",
+				"region": "ewr",
+				"cost": 10,
+				"attached_to_instance": "cb676a46-66fd-4dfb-b839-443f2e6c0b60"
+			},
+			{
+				"id": "c2a0d6ad-8ad0-4b8e-9a2f-2e2f8b5c1d3a",
+				"label": "unattached",
+				"region": "ewr",
+				"cost": 2.5,
+				"attached_to_instance": ""
+			}
+		],
+		"meta": {
+			"total": 2,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseIdleReservedIPs string = `{
+		"reserved_ips": [
+			{
+				"id": "3f26dfe9-6a18-4f3d-a543-0cbca7b3e496",
+				"region": "ewr",
+				"subnet": "192.0.2.2",
+				"label": "attached",
+				"instance_id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60"
+			},
+			{
+				"id": "6f2b1b4b-9a55-4b8e-8f0c-8d0c3e4b9a11",
+				"region": "ewr",
+				"subnet": "192.0.2.1",
+				"label": "unattached",
+				"instance_id": ""
+			},
+			{
+				"id": "9b3e5d1c-2a4f-4c6e-8b7d-1f0e2d3c4b5a",
+				"region": "ewr",
+				"subnet": "192.0.2.10",
+				"label": "unattached-10",
+				"instance_id": ""
+			}
+		],
+		"meta": {
+			"total": 3,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseIdleLoadBalancers string = `{
+		"load_balancers": [
+			{
+				"id": "1317575f-e8a3-4bfb-9d2e-4aaf2f3d8f7c",
+				"region": "ewr",
+				"label": "my-loadbalancer",
+				"status": "active",
+				"instances": []
+			}
+		],
+		"meta": {
+			"total": 1,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseIdleClusters string = `{
+		"vke_clusters": [
+			{
+				"id": "455dcd32-e621-48ee-a10e-0cb5f2d9c1f3",
+				"label": "my-cluster",
+				"region": "ewr",
+				"node_pools": [
+					{
+						"id": "74a5a6a2-3cb2-4a1d-9bd4-52c1e8b5a39d",
+						"label": "active",
+						"plan": "vc2-1c-1gb",
+						"node_quantity": 1,
+						"nodes": [
+							{
+								"id": "f2e7e6c4-04c6-4b0b-a3a4-4b3a6f8e3c9d",
+								"label": "active",
+								"status": "active"
+							}
+						]
+					},
+					{
+						"id": "a8f6e3c2-5b1d-4e7a-9c3f-2d1b8e6a4f5c",
+						"label": "empty",
+						"plan": "vc2-1c-1gb",
+						"node_quantity": 2,
+						"nodes": [
+							{
+								"id": "0b1c2d3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e",
+								"label": "pending",
+								"status": "pending"
+							},
+							{
+								"id": "1c2d3e4f-5a6b-7c8d-9e0f-1a2b3c4d5e6f",
+								"label": "pending",
+								"status": "pending"
+							}
+						]
+					}
+				]
+			}
+		],
+		"meta": {
+			"total": 1,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	prometheusIdleResource string = `
+	# HELP test_idle_resource_monthly_cost_usd Estimated monthly cost (USD) of resources that are billed but idle
+	# TYPE test_idle_resource_monthly_cost_usd gauge
+	test_idle_resource_monthly_cost_usd{id="14b3e7d6-ffb5-4994-8502-57fcd9db3b33",kind="instance",label="stopped",region="ewr"} 20
+	test_idle_resource_monthly_cost_usd{id="1317575f-e8a3-4bfb-9d2e-4aaf2f3d8f7c",kind="load_balancer",label="my-loadbalancer",region="ewr"} 11.40625
+	test_idle_resource_monthly_cost_usd{id="6f2b1b4b-9a55-4b8e-8f0c-8d0c3e4b9a11",kind="reserved_ip",label="unattached",region="ewr"} 2.8515625
+	test_idle_resource_monthly_cost_usd{id="9b3e5d1c-2a4f-4c6e-8b7d-1f0e2d3c4b5a",kind="reserved_ip",label="unattached-10",region="ewr"} 5.703125
+	test_idle_resource_monthly_cost_usd{id="a8f6e3c2-5b1d-4e7a-9c3f-2d1b8e6a4f5c",kind="kubernetes_node_pool",label="empty",region="ewr"} 10
+	test_idle_resource_monthly_cost_usd{id="c2a0d6ad-8ad0-4b8e-9a2f-2e2f8b5c1d3a",kind="block_storage",label="unattached",region="ewr"} 2.5
+	`
+)
+
+func TestIdleResourceCollector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for path, response := range map[string]string{
+		"/v2/plans":                   responseIdlePlans,
+		"/v2/billing/pending-charges": responseIdlePendingCharges,
+		"/v2/instances":               responseIdleInstances,
+		"/v2/blocks":                  responseIdleBlocks,
+		"/v2/reserved-ips":            responseIdleReservedIPs,
+		"/v2/load-balancers":          responseIdleLoadBalancers,
+		"/v2/kubernetes/clusters":     responseIdleClusters,
+	} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if _, err := fmt.Fprint(w, response); err != nil {
+				t.Errorf("unable to write response: %v", err)
+			}
+		})
+	}
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	collector := NewIdleResourceCollector(s, client, log)
+
+	if err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(prometheusIdleResource),
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
+
+func TestPendingMonthlyCost(t *testing.T) {
+	charges := []govultr.InvoiceItem{
+		{Description: "Load Balancer (my-loadbalancer)", Product: "Load Balancer", UnitType: "hours", UnitPrice: 0.5},
+		{Description: "Cloud Compute (192.0.2.1)", Product: "Cloud Compute", UnitType: "hours", UnitPrice: 1},
+		{Description: "Reserved IP (192.0.2.10)", Product: "Reserved IP", UnitType: "months", UnitPrice: 5},
+		{Description: "Reserved IP (192.0.2.1)", Product: "Reserved IP", UnitType: "months", UnitPrice: 3},
+	}
+
+	for _, test := range []struct {
+		name    string
+		product string
+		s       string
+		want    float64
+		ok      bool
+	}{
+		{
+			name:    "Hourly",
+			product: "Load Balancer",
+			s:       "my-loadbalancer",
+			want:    365,
+			ok:      true,
+		},
+		{
+			name:    "Monthly",
+			product: "Reserved IP",
+			s:       "192.0.2.1",
+			want:    3,
+			ok:      true,
+		},
+		{
+			name:    "Prefix",
+			product: "Reserved IP",
+			s:       "192.0.2.10",
+			want:    5,
+			ok:      true,
+		},
+		{
+			name:    "Partial",
+			product: "Load Balancer",
+			s:       "my-load",
+			want:    0,
+			ok:      false,
+		},
+		{
+			name:    "Product",
+			product: "Load Balancer",
+			s:       "192.0.2.1",
+			want:    0,
+			ok:      false,
+		},
+		{
+			name:    "Missing",
+			product: "Reserved IP",
+			s:       "192.0.2.2",
+			want:    0,
+			ok:      false,
+		},
+		{
+			name:    "Empty",
+			product: "Reserved IP",
+			s:       "",
+			want:    0,
+			ok:      false,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := pendingMonthlyCost(charges, test.product, test.s)
+			if ok != test.ok {
+				t.Fatalf("got %t, want %t", ok, test.ok)
+			}
+			if got != test.want {
+				t.Errorf("got %f, want %f", got, test.want)
+			}
+		})
+	}
+}
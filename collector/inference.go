@@ -0,0 +1,159 @@
+package collector
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+// ServerlessInferenceCollector represents Serverless Inference subscriptions
+type ServerlessInferenceCollector struct {
+	System        System
+	Client        *govultr.Client
+	Log           logr.Logger
+	Up            *prometheus.Desc
+	ChatTokens    *prometheus.Desc
+	ChatAllotment *prometheus.Desc
+	ChatOverage   *prometheus.Desc
+	TTSCharacters *prometheus.Desc
+}
+
+// NewServerlessInferenceCollector creates a new ServerlessInferenceCollector
+func NewServerlessInferenceCollector(s System, client *govultr.Client, log logr.Logger) *ServerlessInferenceCollector {
+	subsystem := "inference"
+	return &ServerlessInferenceCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Up: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "up"),
+			"Serverless Inference subscription",
+			[]string{
+				"id",
+				"label",
+			},
+			nil,
+		),
+		ChatTokens: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "chat_tokens"),
+			"Number of chat tokens used in the current month",
+			[]string{
+				"id",
+				"label",
+			},
+			nil,
+		),
+		ChatAllotment: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "chat_tokens_allotment"),
+			"Number of chat tokens included in the plan's monthly allotment",
+			[]string{
+				"id",
+				"label",
+			},
+			nil,
+		),
+		ChatOverage: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "chat_tokens_overage"),
+			"Number of chat tokens used in excess of the monthly allotment",
+			[]string{
+				"id",
+				"label",
+			},
+			nil,
+		),
+		TTSCharacters: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "tts_characters"),
+			"Number of text-to-speech characters used in the current month",
+			[]string{
+				"id",
+				"label",
+				"model",
+			},
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *ServerlessInferenceCollector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx := context.Background()
+
+	// Inference.List does not support pagination
+	subscriptions, _, err := c.Client.Inference.List(ctx)
+	if err != nil {
+		log.Error(err, "Unable to Inference.List")
+		return
+	}
+
+	// Enumerate the subscriptions
+	var wg sync.WaitGroup
+	for _, subscription := range subscriptions {
+		wg.Add(1)
+		go func(subscription govultr.Inference) {
+			defer wg.Done()
+
+			ch <- prometheus.MustNewConstMetric(
+				c.Up,
+				prometheus.CounterValue,
+				1.0,
+				[]string{
+					subscription.ID,
+					subscription.Label,
+				}...,
+			)
+
+			usage, _, err := c.Client.Inference.GetUsage(ctx, subscription.ID)
+			if err != nil {
+				log.Error(err, "Unable to Inference.GetUsage",
+					"id", subscription.ID,
+				)
+				return
+			}
+
+			for desc, value := range map[*prometheus.Desc]int{
+				c.ChatTokens:    usage.Chat.CurrentTokens,
+				c.ChatAllotment: usage.Chat.MonthlyAllotment,
+				c.ChatOverage:   usage.Chat.Overage,
+			} {
+				ch <- prometheus.MustNewConstMetric(
+					desc,
+					prometheus.GaugeValue,
+					float64(value),
+					[]string{
+						subscription.ID,
+						subscription.Label,
+					}...,
+				)
+			}
+			for model, value := range map[string]int{
+				"tts":    usage.Audio.TTSCharacters,
+				"tts_sm": usage.Audio.TTSSMCharacters,
+			} {
+				ch <- prometheus.MustNewConstMetric(
+					c.TTSCharacters,
+					prometheus.GaugeValue,
+					float64(value),
+					[]string{
+						subscription.ID,
+						subscription.Label,
+						model,
+					}...,
+				)
+			}
+		}(subscription)
+	}
+	wg.Wait()
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *ServerlessInferenceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Up
+	ch <- c.ChatTokens
+	ch <- c.ChatAllotment
+	ch <- c.ChatOverage
+	ch <- c.TTSCharacters
+}
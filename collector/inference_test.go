@@ -0,0 +1,91 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	responseInferenceSubscriptions string = `{
+		"subscriptions": [
+			{
+				"id": "6a2c4e8b-3d5f-4a1b-9c7e-0f2d4b6a8c1e",
+				"date_created": "2024-01-01T00:00:00+00:00",
+				"label": "my-inference",
+				"api_key": "secret"
+			}
+		]
+	}`
+	responseInferenceUsage string = `{
+		"usage": {
+			"chat": {
+				"current_tokens": 60000000,
+				"monthly_allotment": 50000000,
+				"overage": 10000000
+			},
+			"audio": {
+				"tts_characters": 1200,
+				"tts_sm_characters": 300
+			}
+		}
+	}`
+	prometheusInference string = `
+	# HELP test_inference_chat_tokens Number of chat tokens used in the current month
+	# TYPE test_inference_chat_tokens gauge
+	test_inference_chat_tokens{id="6a2c4e8b-3d5f-4a1b-9c7e-0f2d4b6a8c1e",label="my-inference"} 6e+07
+	# HELP test_inference_chat_tokens_allotment Number of chat tokens included in the plan's monthly allotment
+	# TYPE test_inference_chat_tokens_allotment gauge
+	test_inference_chat_tokens_allotment{id="6a2c4e8b-3d5f-4a1b-9c7e-0f2d4b6a8c1e",label="my-inference"} 5e+07
+	# HELP test_inference_chat_tokens_overage Number of chat tokens used in excess of the monthly allotment
+	# TYPE test_inference_chat_tokens_overage gauge
+	test_inference_chat_tokens_overage{id="6a2c4e8b-3d5f-4a1b-9c7e-0f2d4b6a8c1e",label="my-inference"} 1e+07
+	# HELP test_inference_tts_characters Number of text-to-speech characters used in the current month
+	# TYPE test_inference_tts_characters gauge
+	test_inference_tts_characters{id="6a2c4e8b-3d5f-4a1b-9c7e-0f2d4b6a8c1e",label="my-inference",model="tts"} 1200
+	test_inference_tts_characters{id="6a2c4e8b-3d5f-4a1b-9c7e-0f2d4b6a8c1e",label="my-inference",model="tts_sm"} 300
+	# HELP test_inference_up Serverless Inference subscription
+	# TYPE test_inference_up counter
+	test_inference_up{id="6a2c4e8b-3d5f-4a1b-9c7e-0f2d4b6a8c1e",label="my-inference"} 1
+	`
+)
+
+func TestServerlessInferenceCollector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for path, response := range map[string]string{
+		"/v2/inference": responseInferenceSubscriptions,
+		"/v2/inference/6a2c4e8b-3d5f-4a1b-9c7e-0f2d4b6a8c1e/usage": responseInferenceUsage,
+	} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if _, err := fmt.Fprint(w, response); err != nil {
+				t.Errorf("unable to write response: %v", err)
+			}
+		})
+	}
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	collector := NewServerlessInferenceCollector(s, client, log)
+
+	if err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(prometheusInference),
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
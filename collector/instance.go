@@ -0,0 +1,166 @@
+package collector
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+// InstanceCollector represents Cloud Compute instances
+type InstanceCollector struct {
+	System           System
+	Client           *govultr.Client
+	Log              logr.Logger
+	Up               *prometheus.Desc
+	VCPUs            *prometheus.Desc
+	RAM              *prometheus.Desc
+	Disk             *prometheus.Desc
+	AllowedBandwidth *prometheus.Desc
+}
+
+// NewInstanceCollector creates a new InstanceCollector
+func NewInstanceCollector(s System, client *govultr.Client, log logr.Logger) *InstanceCollector {
+	subsystem := "instance"
+	labelKeys := []string{
+		"id",
+		"label",
+		"region",
+		"plan",
+		"os",
+		"power_status",
+		"server_status",
+	}
+	return &InstanceCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Up: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "up"),
+			"Instance",
+			append(labelKeys, "status"),
+			nil,
+		),
+		VCPUs: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "vcpu_count"),
+			"Number of vCPUs",
+			labelKeys,
+			nil,
+		),
+		RAM: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "ram"),
+			"Size (MB) of RAM",
+			labelKeys,
+			nil,
+		),
+		Disk: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "disk"),
+			"Size (GB) of Disk",
+			labelKeys,
+			nil,
+		),
+		AllowedBandwidth: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "allowed_bandwidth"),
+			"Allowed monthly Bandwidth (GB)",
+			labelKeys,
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *InstanceCollector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx := context.Background()
+
+	// Get all instances across all pages
+	var allInstances []govultr.Instance
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		instances, meta, _, err := c.Client.Instance.List(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to Instance.List")
+			return
+		}
+
+		allInstances = append(allInstances, instances...)
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	// Enumerate the instances
+	var wg sync.WaitGroup
+	for _, instance := range allInstances {
+		wg.Add(1)
+		go func(instance govultr.Instance) {
+			defer wg.Done()
+
+			labelValues := []string{
+				instance.ID,
+				instance.Label,
+				instance.Region,
+				instance.Plan,
+				instance.Os,
+				instance.PowerStatus,
+				instance.ServerStatus,
+			}
+
+			ch <- prometheus.MustNewConstMetric(
+				c.Up,
+				prometheus.CounterValue,
+				func(status string) (result float64) {
+					if status == "active" {
+						result = 1.0
+					}
+					return result
+				}(instance.Status),
+				append(labelValues, instance.Status)...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.VCPUs,
+				prometheus.GaugeValue,
+				float64(instance.VCPUCount),
+				labelValues...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.RAM,
+				prometheus.GaugeValue,
+				float64(instance.RAM),
+				labelValues...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.Disk,
+				prometheus.GaugeValue,
+				float64(instance.Disk),
+				labelValues...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.AllowedBandwidth,
+				prometheus.GaugeValue,
+				float64(instance.AllowedBandwidth),
+				labelValues...,
+			)
+		}(instance)
+	}
+	wg.Wait()
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *InstanceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Up
+	ch <- c.VCPUs
+	ch <- c.RAM
+	ch <- c.Disk
+	ch <- c.AllowedBandwidth
+}
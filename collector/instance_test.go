@@ -0,0 +1,88 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	responseInstances string = `{
+		"instances": [
+			{
+				"id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+				"os": "Debian 12 x64 (bookworm)",
+				"ram": 1024,
+				"disk": 25,
+				"plan": "vc2-1c-1gb",
+				"vcpu_count": 1,
+				"region": "ewr",
+				"status": "active",
+				"allowed_bandwidth": 1000,
+				"power_status": "running",
+				"server_status": "ok",
+				"label": "my-instance"
+			}
+		],
+		"meta": {
+			"total": 1,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	prometheusInstances string = `
+	# HELP test_instance_allowed_bandwidth Allowed monthly Bandwidth (GB)
+	# TYPE test_instance_allowed_bandwidth gauge
+	test_instance_allowed_bandwidth{id="cb676a46-66fd-4dfb-b839-443f2e6c0b60",label="my-instance",os="Debian 12 x64 (bookworm)",plan="vc2-1c-1gb",power_status="running",region="ewr",server_status="ok"} 1000
+	# HELP test_instance_disk Size (GB) of Disk
+	# TYPE test_instance_disk gauge
+	test_instance_disk{id="cb676a46-66fd-4dfb-b839-443f2e6c0b60",label="my-instance",os="Debian 12 x64 (bookworm)",plan="vc2-1c-1gb",power_status="running",region="ewr",server_status="ok"} 25
+	# HELP test_instance_ram Size (MB) of RAM
+	# TYPE test_instance_ram gauge
+	test_instance_ram{id="cb676a46-66fd-4dfb-b839-443f2e6c0b60",label="my-instance",os="Debian 12 x64 (bookworm)",plan="vc2-1c-1gb",power_status="running",region="ewr",server_status="ok"} 1024
+	# HELP test_instance_up Instance
+	# TYPE test_instance_up counter
+	test_instance_up{id="cb676a46-66fd-4dfb-b839-443f2e6c0b60",label="my-instance",os="Debian 12 x64 (bookworm)",plan="vc2-1c-1gb",power_status="running",region="ewr",server_status="ok",status="active"} 1
+	# HELP test_instance_vcpu_count Number of vCPUs
+	# TYPE test_instance_vcpu_count gauge
+	test_instance_vcpu_count{id="cb676a46-66fd-4dfb-b839-443f2e6c0b60",label="my-instance",os="Debian 12 x64 (bookworm)",plan="vc2-1c-1gb",power_status="running",region="ewr",server_status="ok"} 1
+	`
+)
+
+func TestInstanceCollector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// Overrides the response that the client receives when it calls Instance.List
+	mux.HandleFunc("/v2/instances", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := fmt.Fprint(w, responseInstances); err != nil {
+			t.Errorf("unable to write response: %v", err)
+		}
+	})
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	collector := NewInstanceCollector(s, client, log)
+
+	if err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(prometheusInstances),
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
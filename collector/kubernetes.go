@@ -18,15 +18,35 @@ type KubernetesCollector struct {
 	Up        *prometheus.Desc
 	NodePools *prometheus.Desc
 	Nodes     *prometheus.Desc
+
+	// Versions compare each cluster's version with the supported versions
+	MinorVersionsBehind *prometheus.Desc
+	PatchVersionsBehind *prometheus.Desc
+	VersionSupported    *prometheus.Desc
+	Upgrades            *prometheus.Desc
+
+	// NodeCollector is a nested collector
+	// It is used to collect metrics for the Node Pools' individual Nodes
+	NodeCollector KubernetesNodeCollector
 }
 
 // NewKubernetesCollector creates a new KubernetesCollector
 func NewKubernetesCollector(s System, client *govultr.Client, log logr.Logger) *KubernetesCollector {
 	subsystem := "kubernetes"
+
+	nodes := NewKubernetesNodeCollector(System{
+		Namespace: s.Namespace,
+		Subsystem: subsystem,
+		Version:   s.Version,
+	}, client, log)
+
 	return &KubernetesCollector{
 		System: s,
 		Client: client,
 		Log:    log,
+
+		NodeCollector: nodes,
+
 		Up: prometheus.NewDesc(
 			prometheus.BuildFQName(s.Namespace, subsystem, "cluster_up"),
 			"Kubernetes cluster",
@@ -60,6 +80,47 @@ func NewKubernetesCollector(s System, client *govultr.Client, log logr.Logger) *
 			},
 			nil,
 		),
+		MinorVersionsBehind: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "cluster_minor_versions_behind"),
+			"Number of newer supported minor versions",
+			[]string{
+				"label",
+				"region",
+				"version",
+			},
+			nil,
+		),
+		PatchVersionsBehind: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "cluster_patch_versions_behind"),
+			"Number of newer supported patch versions of the cluster's minor version",
+			[]string{
+				"label",
+				"region",
+				"version",
+			},
+			nil,
+		),
+		VersionSupported: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "cluster_version_supported"),
+			"Whether the cluster's version is supported (1=supported)",
+			[]string{
+				"label",
+				"region",
+				"version",
+			},
+			nil,
+		),
+		Upgrades: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "cluster_upgrade_available"),
+			"A metric with a constant '1' value labeled by each version to which the cluster may be upgraded",
+			[]string{
+				"label",
+				"region",
+				"version",
+				"target_version",
+			},
+			nil,
+		),
 	}
 }
 
@@ -92,6 +153,15 @@ func (c *KubernetesCollector) Collect(ch chan<- prometheus.Metric) {
 		options.Cursor = meta.Links.Next
 	}
 
+	// Get the supported versions
+	// If these are unavailable, the clusters' version lag metrics are not collected
+	var versions []string
+	if v, _, err := c.Client.Kubernetes.GetVersions(ctx); err != nil {
+		log.Error(err, "Unable to Kubernetes.GetVersions")
+	} else {
+		versions = v.Versions
+	}
+
 	// Enumerate all of the clusters
 	var wg sync.WaitGroup
 	for _, cluster := range allClusters {
@@ -139,13 +209,100 @@ func (c *KubernetesCollector) Collect(ch chan<- prometheus.Metric) {
 					}...,
 				)
 			}
+
+			// Collect Node metrics
+			c.NodeCollector.Collect(ch, cluster)
+
+			// Collect Version metrics
+			c.collectVersions(ctx, ch, cluster, versions)
 		}(cluster)
 	}
 	wg.Wait()
 }
 
+// collectVersions collects metrics comparing the cluster's version with the supported versions
+// and the versions to which the cluster may be upgraded
+func (c *KubernetesCollector) collectVersions(ctx context.Context, ch chan<- prometheus.Metric, cluster govultr.Cluster, versions []string) {
+	log := c.Log.WithName("collectVersions")
+
+	if versions != nil {
+		minor, patch, supported, err := kubernetesVersionLag(cluster.Version, versions)
+		if err != nil {
+			log.Error(err, "Unable to compare cluster version",
+				"id", cluster.ID,
+				"version", cluster.Version,
+			)
+		} else {
+			ch <- prometheus.MustNewConstMetric(
+				c.MinorVersionsBehind,
+				prometheus.GaugeValue,
+				float64(minor),
+				[]string{
+					cluster.Label,
+					cluster.Region,
+					cluster.Version,
+				}...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.PatchVersionsBehind,
+				prometheus.GaugeValue,
+				float64(patch),
+				[]string{
+					cluster.Label,
+					cluster.Region,
+					cluster.Version,
+				}...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.VersionSupported,
+				prometheus.GaugeValue,
+				func(supported bool) (result float64) {
+					if supported {
+						result = 1.0
+					}
+					return result
+				}(supported),
+				[]string{
+					cluster.Label,
+					cluster.Region,
+					cluster.Version,
+				}...,
+			)
+		}
+	}
+
+	upgrades, _, err := c.Client.Kubernetes.GetUpgrades(ctx, cluster.ID)
+	if err != nil {
+		log.Error(err, "Unable to Kubernetes.GetUpgrades",
+			"id", cluster.ID,
+		)
+		return
+	}
+	for _, upgrade := range upgrades {
+		ch <- prometheus.MustNewConstMetric(
+			c.Upgrades,
+			prometheus.GaugeValue,
+			1.0,
+			[]string{
+				cluster.Label,
+				cluster.Region,
+				cluster.Version,
+				upgrade,
+			}...,
+		)
+	}
+}
+
 // Describe implements Prometheus' Collector interface and is used to describe metrics
 func (c *KubernetesCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- c.Up
 	ch <- c.NodePools
+	ch <- c.Nodes
+	ch <- c.MinorVersionsBehind
+	ch <- c.PatchVersionsBehind
+	ch <- c.VersionSupported
+	ch <- c.Upgrades
+
+	// Describe Node metrics
+	c.NodeCollector.Describe(ch)
 }
@@ -0,0 +1,151 @@
+package collector
+
+import (
+	"strconv"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+// KubernetesNodeCollector represents the Node Pools' Nodes of a Kubernetes cluster
+// It does not implement prometheus.Collector interface because Collect accepts a Cluster
+// This is necessary because the Nodes are returned as part of the (parent) Cluster
+type KubernetesNodeCollector struct {
+	System System
+	Client *govultr.Client
+	Log    logr.Logger
+
+	Up       *prometheus.Desc
+	Created  *prometheus.Desc
+	MinNodes *prometheus.Desc
+	MaxNodes *prometheus.Desc
+}
+
+// NewKubernetesNodeCollector creates a new KubernetesNodeCollector
+func NewKubernetesNodeCollector(s System, client *govultr.Client, log logr.Logger) KubernetesNodeCollector {
+	return KubernetesNodeCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+
+		Up: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, s.Subsystem, "node_up"),
+			"Kubernetes Node",
+			[]string{
+				"cluster",
+				"node_pool",
+				"id",
+				"label",
+				"status",
+			},
+			nil,
+		),
+		Created: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, s.Subsystem, "node_created_time"),
+			"Kubernetes Node creation time in Unix epoch seconds",
+			[]string{
+				"cluster",
+				"node_pool",
+				"id",
+				"label",
+			},
+			nil,
+		),
+		MinNodes: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, s.Subsystem, "node_pool_min_nodes"),
+			"Minimum number of Nodes in the Node Pool when auto-scaling",
+			[]string{
+				"cluster",
+				"node_pool",
+				"auto_scaler",
+			},
+			nil,
+		),
+		MaxNodes: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, s.Subsystem, "node_pool_max_nodes"),
+			"Maximum number of Nodes in the Node Pool when auto-scaling",
+			[]string{
+				"cluster",
+				"node_pool",
+				"auto_scaler",
+			},
+			nil,
+		),
+	}
+}
+
+// Collect collects metrics for each of the Cluster's Node Pools and their Nodes
+func (c *KubernetesNodeCollector) Collect(ch chan<- prometheus.Metric, cluster govultr.Cluster) {
+	log := c.Log.WithName("Collect")
+
+	for _, nodepool := range cluster.NodePools {
+		ch <- prometheus.MustNewConstMetric(
+			c.MinNodes,
+			prometheus.GaugeValue,
+			float64(nodepool.MinNodes),
+			[]string{
+				cluster.Label,
+				nodepool.Label,
+				strconv.FormatBool(nodepool.AutoScaler),
+			}...,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.MaxNodes,
+			prometheus.GaugeValue,
+			float64(nodepool.MaxNodes),
+			[]string{
+				cluster.Label,
+				nodepool.Label,
+				strconv.FormatBool(nodepool.AutoScaler),
+			}...,
+		)
+
+		for _, node := range nodepool.Nodes {
+			ch <- prometheus.MustNewConstMetric(
+				c.Up,
+				prometheus.CounterValue,
+				func(status string) (result float64) {
+					if status == "active" {
+						result = 1.0
+					}
+					return result
+				}(node.Status),
+				[]string{
+					cluster.Label,
+					nodepool.Label,
+					node.ID,
+					node.Label,
+					node.Status,
+				}...,
+			)
+
+			created, err := parseTime(node.DateCreated)
+			if err != nil {
+				log.Error(err, "Unable to parse Node creation time",
+					"id", node.ID,
+				)
+				continue
+			}
+			ch <- prometheus.MustNewConstMetric(
+				c.Created,
+				prometheus.GaugeValue,
+				float64(created.Unix()),
+				[]string{
+					cluster.Label,
+					nodepool.Label,
+					node.ID,
+					node.Label,
+				}...,
+			)
+		}
+	}
+}
+
+// Describe describes metrics
+func (c *KubernetesNodeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Up
+	ch <- c.Created
+	ch <- c.MinNodes
+	ch <- c.MaxNodes
+}
@@ -0,0 +1,142 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	responseClusters string = `{
+		"vke_clusters": [
+			{
+				"id": "455dcd32-e621-48ee-a10e-0cb6b77a4a2a",
+				"label": "my-cluster",
+				"version": "v1.32.1+1",
+				"region": "ewr",
+				"status": "active",
+				"node_pools": [
+					{
+						"id": "74cf8e2f-2f06-4f2f-9f4a-2b8b9bd6a6d4",
+						"label": "my-pool",
+						"plan": "vc2-2c-4gb",
+						"status": "active",
+						"node_quantity": 2,
+						"min_nodes": 1,
+						"max_nodes": 4,
+						"auto_scaler": true,
+						"tag": "my-tag",
+						"nodes": [
+							{
+								"id": "f2e0a9b8-5b26-4b8e-8e2a-5bd3e5f7c6a1",
+								"date_created": "2024-01-01T00:00:00+00:00",
+								"label": "my-pool-1",
+								"status": "active"
+							},
+							{
+								"id": "0c3a2e1b-8d6f-4a1c-9e7b-3f2d1c0b9a8e",
+								"date_created": "2024-01-02T00:00:00+00:00",
+								"label": "my-pool-2",
+								"status": "pending"
+							}
+						]
+					}
+				]
+			}
+		],
+		"meta": {
+			"total": 1,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseVersions string = `{
+		"versions": ["v1.33.2+1", "v1.33.1+1", "v1.32.3+1", "v1.32.2+1", "v1.32.1+1", "v1.31.5+1"]
+	}`
+	responseUpgrades string = `{
+		"available_upgrades": ["v1.32.3+1", "v1.33.2+1"]
+	}`
+	prometheusClusters string = `
+	# HELP test_kubernetes_cluster_minor_versions_behind Number of newer supported minor versions
+	# TYPE test_kubernetes_cluster_minor_versions_behind gauge
+	test_kubernetes_cluster_minor_versions_behind{label="my-cluster",region="ewr",version="v1.32.1+1"} 1
+	# HELP test_kubernetes_cluster_patch_versions_behind Number of newer supported patch versions of the cluster's minor version
+	# TYPE test_kubernetes_cluster_patch_versions_behind gauge
+	test_kubernetes_cluster_patch_versions_behind{label="my-cluster",region="ewr",version="v1.32.1+1"} 2
+	# HELP test_kubernetes_cluster_up Kubernetes cluster
+	# TYPE test_kubernetes_cluster_up counter
+	test_kubernetes_cluster_up{label="my-cluster",region="ewr",status="active",version="v1.32.1+1"} 1
+	# HELP test_kubernetes_cluster_upgrade_available A metric with a constant '1' value labeled by each version to which the cluster may be upgraded
+	# TYPE test_kubernetes_cluster_upgrade_available gauge
+	test_kubernetes_cluster_upgrade_available{label="my-cluster",region="ewr",target_version="v1.32.3+1",version="v1.32.1+1"} 1
+	test_kubernetes_cluster_upgrade_available{label="my-cluster",region="ewr",target_version="v1.33.2+1",version="v1.32.1+1"} 1
+	# HELP test_kubernetes_cluster_version_supported Whether the cluster's version is supported (1=supported)
+	# TYPE test_kubernetes_cluster_version_supported gauge
+	test_kubernetes_cluster_version_supported{label="my-cluster",region="ewr",version="v1.32.1+1"} 1
+	# HELP test_kubernetes_node_created_time Kubernetes Node creation time in Unix epoch seconds
+	# TYPE test_kubernetes_node_created_time gauge
+	test_kubernetes_node_created_time{cluster="my-cluster",id="0c3a2e1b-8d6f-4a1c-9e7b-3f2d1c0b9a8e",label="my-pool-2",node_pool="my-pool"} 1.7041536e+09
+	test_kubernetes_node_created_time{cluster="my-cluster",id="f2e0a9b8-5b26-4b8e-8e2a-5bd3e5f7c6a1",label="my-pool-1",node_pool="my-pool"} 1.7040672e+09
+	# HELP test_kubernetes_node_pool Number of Node Pools
+	# TYPE test_kubernetes_node_pool gauge
+	test_kubernetes_node_pool{label="my-cluster",region="ewr",status="active",version="v1.32.1+1"} 1
+	# HELP test_kubernetes_node_pool_max_nodes Maximum number of Nodes in the Node Pool when auto-scaling
+	# TYPE test_kubernetes_node_pool_max_nodes gauge
+	test_kubernetes_node_pool_max_nodes{auto_scaler="true",cluster="my-cluster",node_pool="my-pool"} 4
+	# HELP test_kubernetes_node_pool_min_nodes Minimum number of Nodes in the Node Pool when auto-scaling
+	# TYPE test_kubernetes_node_pool_min_nodes gauge
+	test_kubernetes_node_pool_min_nodes{auto_scaler="true",cluster="my-cluster",node_pool="my-pool"} 1
+	# HELP test_kubernetes_node_pool_nodes Number of Nodes
+	# TYPE test_kubernetes_node_pool_nodes gauge
+	test_kubernetes_node_pool_nodes{label="my-pool",plan="vc2-2c-4gb",status="active",tag="my-tag"} 2
+	# HELP test_kubernetes_node_up Kubernetes Node
+	# TYPE test_kubernetes_node_up counter
+	test_kubernetes_node_up{cluster="my-cluster",id="0c3a2e1b-8d6f-4a1c-9e7b-3f2d1c0b9a8e",label="my-pool-2",node_pool="my-pool",status="pending"} 0
+	test_kubernetes_node_up{cluster="my-cluster",id="f2e0a9b8-5b26-4b8e-8e2a-5bd3e5f7c6a1",label="my-pool-1",node_pool="my-pool",status="active"} 1
+	`
+)
+
+func TestKubernetesCollector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// Overrides the responses that the client receives when it calls
+	// Kubernetes.ListClusters, Kubernetes.GetVersions and Kubernetes.GetUpgrades
+	for path, response := range map[string]string{
+		"/v2/kubernetes/clusters": responseClusters,
+		"/v2/kubernetes/versions": responseVersions,
+		"/v2/kubernetes/clusters/455dcd32-e621-48ee-a10e-0cb6b77a4a2a/available-upgrades": responseUpgrades,
+	} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if _, err := fmt.Fprint(w, response); err != nil {
+				t.Errorf("unable to write response: %v", err)
+			}
+		})
+	}
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	collector := NewKubernetesCollector(s, client, log)
+
+	if err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(prometheusClusters),
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
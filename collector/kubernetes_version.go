@@ -0,0 +1,100 @@
+package collector
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// kubernetesVersion represents a VKE version (e.g. "v1.32.1+1")
+type kubernetesVersion struct {
+	Major int
+	Minor int
+	Patch int
+	Build int
+}
+
+// parseKubernetesVersion parses a VKE version (e.g. "v1.32.1+1")
+// The build suffix (e.g. "+1") is optional
+func parseKubernetesVersion(s string) (kubernetesVersion, error) {
+	v := kubernetesVersion{}
+
+	rest, build, found := strings.Cut(strings.TrimPrefix(s, "v"), "+")
+	if found {
+		b, err := strconv.Atoi(build)
+		if err != nil {
+			return v, fmt.Errorf("invalid build in version %q", s)
+		}
+		v.Build = b
+	}
+
+	parts := strings.Split(rest, ".")
+	if len(parts) != 3 {
+		return v, fmt.Errorf("invalid version %q", s)
+	}
+	for i, dst := range []*int{&v.Major, &v.Minor, &v.Patch} {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil {
+			return v, fmt.Errorf("invalid version %q", s)
+		}
+		*dst = n
+	}
+	return v, nil
+}
+
+// sameMinor returns true if both versions have the same major and minor versions
+func (v kubernetesVersion) sameMinor(o kubernetesVersion) bool {
+	return v.Major == o.Major && v.Minor == o.Minor
+}
+
+// newerMinor returns true if o's major.minor is newer than v's
+func (v kubernetesVersion) newerMinor(o kubernetesVersion) bool {
+	if o.Major != v.Major {
+		return o.Major > v.Major
+	}
+	return o.Minor > v.Minor
+}
+
+// newerPatch returns true if o is a newer patch (or build) of v's major.minor
+func (v kubernetesVersion) newerPatch(o kubernetesVersion) bool {
+	if !v.sameMinor(o) {
+		return false
+	}
+	if o.Patch != v.Patch {
+		return o.Patch > v.Patch
+	}
+	return o.Build > v.Build
+}
+
+// kubernetesVersionLag returns how far the current version is behind the supported versions
+// minor is the number of (distinct) newer minor versions that are supported
+// patch is the number of newer patch versions of the current minor version that are supported
+// supported is true if the current version is one of the supported versions
+func kubernetesVersionLag(current string, versions []string) (minor, patch int, supported bool, err error) {
+	c, err := parseKubernetesVersion(current)
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	minors := make(map[[2]int]bool)
+	for _, version := range versions {
+		if version == current {
+			supported = true
+		}
+
+		v, err := parseKubernetesVersion(version)
+		if err != nil {
+			// Ignore versions that can't be parsed
+			continue
+		}
+
+		if c.newerMinor(v) {
+			minors[[2]int{v.Major, v.Minor}] = true
+		}
+		if c.newerPatch(v) {
+			patch++
+		}
+	}
+
+	return len(minors), patch, supported, nil
+}
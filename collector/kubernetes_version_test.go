@@ -0,0 +1,58 @@
+package collector
+
+import (
+	"testing"
+)
+
+func TestParseKubernetesVersion(t *testing.T) {
+	for got, want := range map[string]kubernetesVersion{
+		"v1.32.1+1": {Major: 1, Minor: 32, Patch: 1, Build: 1},
+		"v1.30.0":   {Major: 1, Minor: 30, Patch: 0, Build: 0},
+		"1.29.10+2": {Major: 1, Minor: 29, Patch: 10, Build: 2},
+	} {
+		t.Run(got, func(t *testing.T) {
+			got, err := parseKubernetesVersion(got)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != want {
+				t.Errorf("got %+v, want %+v", got, want)
+			}
+		})
+	}
+
+	for _, s := range []string{"", "v1.32", "v1.32.x+1", "v1.32.1+x"} {
+		t.Run(s, func(t *testing.T) {
+			if _, err := parseKubernetesVersion(s); err == nil {
+				t.Errorf("expected error parsing %q", s)
+			}
+		})
+	}
+}
+
+func TestKubernetesVersionLag(t *testing.T) {
+	versions := []string{"v1.33.2+1", "v1.33.1+1", "v1.32.3+1", "v1.32.2+1", "v1.31.5+1"}
+
+	for _, test := range []struct {
+		current   string
+		minor     int
+		patch     int
+		supported bool
+	}{
+		{"v1.33.2+1", 0, 0, true},
+		{"v1.33.1+1", 0, 1, true},
+		{"v1.32.2+1", 1, 1, true},
+		{"v1.31.5+1", 2, 0, true},
+		{"v1.30.9+1", 3, 0, false},
+	} {
+		t.Run(test.current, func(t *testing.T) {
+			minor, patch, supported, err := kubernetesVersionLag(test.current, versions)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if minor != test.minor || patch != test.patch || supported != test.supported {
+				t.Errorf("got (%d, %d, %t), want (%d, %d, %t)", minor, patch, supported, test.minor, test.patch, test.supported)
+			}
+		})
+	}
+}
@@ -2,7 +2,14 @@ package collector
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/prometheus/client_golang/prometheus"
@@ -11,20 +18,38 @@ import (
 
 // LoadBalancerCollector represents Load Balancers
 type LoadBalancerCollector struct {
-	System    System
-	Client    *govultr.Client
-	Log       logr.Logger
+	System System
+	Client *govultr.Client
+	Log    logr.Logger
+
+	// CheckSSL enables retrieving SSL certificates from Load balancers' HTTPS frontends
+	CheckSSL bool
+
 	Up        *prometheus.Desc
 	Instances *prometheus.Desc
+
+	ForwardingRules               *prometheus.Desc
+	HealthCheck                   *prometheus.Desc
+	HealthCheckInterval           *prometheus.Desc
+	HealthCheckResponseTimeout    *prometheus.Desc
+	HealthCheckHealthyThreshold   *prometheus.Desc
+	HealthCheckUnhealthyThreshold *prometheus.Desc
+	StickySessions                *prometheus.Desc
+	ProxyProtocol                 *prometheus.Desc
+	SSLProbeSuccess               *prometheus.Desc
+	SSLExpiry                     *prometheus.Desc
 }
 
 // NewLoadBalancerCollector creates a new LoadBalancerCollector
-func NewLoadBalancerCollector(s System, client *govultr.Client, log logr.Logger) *LoadBalancerCollector {
+func NewLoadBalancerCollector(s System, client *govultr.Client, checkSSL bool, log logr.Logger) *LoadBalancerCollector {
 	subsystem := "load_balancer"
 	return &LoadBalancerCollector{
 		System: s,
 		Client: client,
 		Log:    log,
+
+		CheckSSL: checkSSL,
+
 		Up: prometheus.NewDesc(
 			prometheus.BuildFQName(s.Namespace, subsystem, "up"),
 			"Load balancer",
@@ -36,7 +61,7 @@ func NewLoadBalancerCollector(s System, client *govultr.Client, log logr.Logger)
 			nil,
 		),
 		Instances: prometheus.NewDesc(
-			prometheus.BuildFQName(s.Namespace, s.Subsystem, "instances"),
+			prometheus.BuildFQName(s.Namespace, subsystem, "instances"),
 			"Number of Load balancer instances",
 			[]string{
 				"label",
@@ -45,6 +70,108 @@ func NewLoadBalancerCollector(s System, client *govultr.Client, log logr.Logger)
 			},
 			nil,
 		),
+		ForwardingRules: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "forwarding_rules"),
+			"Number of Load balancer forwarding rules",
+			[]string{
+				"label",
+				"region",
+				"status",
+			},
+			nil,
+		),
+		HealthCheck: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "health_check_info"),
+			"A metric with a constant '1' value labeled by the Load balancer's health check protocol, port and path",
+			[]string{
+				"label",
+				"region",
+				"protocol",
+				"port",
+				"path",
+			},
+			nil,
+		),
+		HealthCheckInterval: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "health_check_interval_seconds"),
+			"Interval (seconds) between Load balancer health checks",
+			[]string{
+				"label",
+				"region",
+				"status",
+			},
+			nil,
+		),
+		HealthCheckResponseTimeout: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "health_check_response_timeout_seconds"),
+			"Timeout (seconds) of Load balancer health checks",
+			[]string{
+				"label",
+				"region",
+				"status",
+			},
+			nil,
+		),
+		HealthCheckHealthyThreshold: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "health_check_healthy_threshold"),
+			"Number of successful Load balancer health checks before an instance is healthy",
+			[]string{
+				"label",
+				"region",
+				"status",
+			},
+			nil,
+		),
+		HealthCheckUnhealthyThreshold: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "health_check_unhealthy_threshold"),
+			"Number of failed Load balancer health checks before an instance is unhealthy",
+			[]string{
+				"label",
+				"region",
+				"status",
+			},
+			nil,
+		),
+		StickySessions: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "sticky_sessions"),
+			"Whether the Load balancer uses sticky sessions (1=enabled)",
+			[]string{
+				"label",
+				"region",
+				"status",
+			},
+			nil,
+		),
+		ProxyProtocol: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "proxy_protocol"),
+			"Whether the Load balancer uses the PROXY protocol (1=enabled)",
+			[]string{
+				"label",
+				"region",
+				"status",
+			},
+			nil,
+		),
+		SSLProbeSuccess: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "ssl_probe_success"),
+			"Whether the Load balancer's SSL certificate was retrieved (1=success)",
+			[]string{
+				"label",
+				"region",
+				"server_name",
+			},
+			nil,
+		),
+		SSLExpiry: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "ssl_certificate_expiry_time"),
+			"Expiry time of the Load balancer's SSL certificate in Unix epoch seconds",
+			[]string{
+				"label",
+				"region",
+				"server_name",
+			},
+			nil,
+		),
 	}
 }
 
@@ -109,6 +236,119 @@ func (c *LoadBalancerCollector) Collect(ch chan<- prometheus.Metric) {
 					lb.Status,
 				}...,
 			)
+			ch <- prometheus.MustNewConstMetric(
+				c.ForwardingRules,
+				prometheus.GaugeValue,
+				float64(len(lb.ForwardingRules)),
+				[]string{
+					lb.Label,
+					lb.Region,
+					lb.Status,
+				}...,
+			)
+
+			if hc := lb.HealthCheck; hc != nil {
+				ch <- prometheus.MustNewConstMetric(
+					c.HealthCheck,
+					prometheus.GaugeValue,
+					1.0,
+					[]string{
+						lb.Label,
+						lb.Region,
+						hc.Protocol,
+						strconv.Itoa(hc.Port),
+						hc.Path,
+					}...,
+				)
+
+				// Map of metric to its value
+				for desc, value := range map[*prometheus.Desc]int{
+					c.HealthCheckInterval:           hc.CheckInterval,
+					c.HealthCheckResponseTimeout:    hc.ResponseTimeout,
+					c.HealthCheckHealthyThreshold:   hc.HealthyThreshold,
+					c.HealthCheckUnhealthyThreshold: hc.UnhealthyThreshold,
+				} {
+					ch <- prometheus.MustNewConstMetric(
+						desc,
+						prometheus.GaugeValue,
+						float64(value),
+						[]string{
+							lb.Label,
+							lb.Region,
+							lb.Status,
+						}...,
+					)
+				}
+			}
+
+			var sticky, proxy bool
+			if info := lb.GenericInfo; info != nil {
+				sticky = info.StickySessions != nil && info.StickySessions.CookieName != ""
+				proxy = info.ProxyProtocol != nil && *info.ProxyProtocol
+			}
+			for desc, value := range map[*prometheus.Desc]bool{
+				c.StickySessions: sticky,
+				c.ProxyProtocol:  proxy,
+			} {
+				ch <- prometheus.MustNewConstMetric(
+					desc,
+					prometheus.GaugeValue,
+					func(enabled bool) (result float64) {
+						if enabled {
+							result = 1.0
+						}
+						return result
+					}(value),
+					[]string{
+						lb.Label,
+						lb.Region,
+						lb.Status,
+					}...,
+				)
+			}
+
+			// The Vultr API does not return the SSL certificate
+			// When enabled, it is retrieved from the Load balancer's HTTPS frontend
+			if !c.CheckSSL || lb.SSLInfo == nil || !*lb.SSLInfo {
+				return
+			}
+			port := httpsFrontendPort(lb.ForwardingRules)
+			if port == 0 || lb.IPV4 == "" {
+				return
+			}
+			serverName := autoSSLServerName(lb.AutoSSL)
+			expiry, err := certificateExpiry(ctx, net.JoinHostPort(lb.IPV4, strconv.Itoa(port)), serverName)
+			ch <- prometheus.MustNewConstMetric(
+				c.SSLProbeSuccess,
+				prometheus.GaugeValue,
+				func(err error) (result float64) {
+					if err == nil {
+						result = 1.0
+					}
+					return result
+				}(err),
+				[]string{
+					lb.Label,
+					lb.Region,
+					serverName,
+				}...,
+			)
+			if err != nil {
+				log.Error(err, "Unable to get SSL certificate",
+					"id", lb.ID,
+				)
+				return
+			}
+			ch <- prometheus.MustNewConstMetric(
+				c.SSLExpiry,
+				prometheus.GaugeValue,
+				float64(expiry.Unix()),
+				[]string{
+					lb.Label,
+					lb.Region,
+					serverName,
+				}...,
+			)
 		}(loadbalancer)
 	}
 	wg.Wait()
@@ -118,4 +358,63 @@ func (c *LoadBalancerCollector) Collect(ch chan<- prometheus.Metric) {
 func (c *LoadBalancerCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- c.Up
 	ch <- c.Instances
+	ch <- c.ForwardingRules
+	ch <- c.HealthCheck
+	ch <- c.HealthCheckInterval
+	ch <- c.HealthCheckResponseTimeout
+	ch <- c.HealthCheckHealthyThreshold
+	ch <- c.HealthCheckUnhealthyThreshold
+	ch <- c.StickySessions
+	ch <- c.ProxyProtocol
+	ch <- c.SSLProbeSuccess
+	ch <- c.SSLExpiry
+}
+
+// httpsFrontendPort returns the frontend port of the first HTTPS forwarding rule (or 0 if there's none)
+func httpsFrontendPort(rules []govultr.ForwardingRule) int {
+	for _, rule := range rules {
+		if strings.EqualFold(rule.FrontendProtocol, "https") {
+			return rule.FrontendPort
+		}
+	}
+	return 0
+}
+
+// autoSSLServerName returns the domain for which an Auto SSL certificate was issued (or "" if there's none)
+func autoSSLServerName(autoSSL *govultr.AutoSSL) string {
+	if autoSSL == nil {
+		return ""
+	}
+	if autoSSL.Domain != "" {
+		return autoSSL.Domain
+	}
+	if autoSSL.DomainSub != "" && autoSSL.DomainZone != "" {
+		return fmt.Sprintf("%s.%s", autoSSL.DomainSub, autoSSL.DomainZone)
+	}
+	return autoSSL.DomainZone
+}
+
+// certificateExpiry returns the expiry (NotAfter) of the leaf certificate presented by the TLS server at addr
+func certificateExpiry(ctx context.Context, addr, serverName string) (time.Time, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	dialer := &tls.Dialer{
+		Config: &tls.Config{
+			ServerName: serverName,
+			// The certificate is only inspected; it must be retrievable even if it has expired
+			InsecureSkipVerify: true, //nolint:gosec
+		},
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+
+	certs := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return time.Time{}, errors.New("no certificate presented")
+	}
+	return certs[0].NotAfter, nil
 }
@@ -0,0 +1,219 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	stdlog "log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/vultr/govultr/v3"
+)
+
+func TestHTTPSFrontendPort(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		rules []govultr.ForwardingRule
+		want  int
+	}{
+		{
+			name:  "No rules",
+			rules: nil,
+			want:  0,
+		},
+		{
+			name: "HTTP only",
+			rules: []govultr.ForwardingRule{
+				{FrontendProtocol: "http", FrontendPort: 80},
+			},
+			want: 0,
+		},
+		{
+			name: "HTTP and HTTPS",
+			rules: []govultr.ForwardingRule{
+				{FrontendProtocol: "http", FrontendPort: 80},
+				{FrontendProtocol: "https", FrontendPort: 8443},
+			},
+			want: 8443,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if got := httpsFrontendPort(test.rules); got != test.want {
+				t.Errorf("got %d, want %d", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAutoSSLServerName(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		autoSSL *govultr.AutoSSL
+		want    string
+	}{
+		{
+			name:    "No Auto SSL",
+			autoSSL: nil,
+			want:    "",
+		},
+		{
+			name:    "Domain",
+			autoSSL: &govultr.AutoSSL{Domain: "www.example.com"},
+			want:    "www.example.com",
+		},
+		{
+			name:    "Subdomain and zone",
+			autoSSL: &govultr.AutoSSL{DomainZone: "example.com", DomainSub: "www"},
+			want:    "www.example.com",
+		},
+		{
+			name:    "Zone",
+			autoSSL: &govultr.AutoSSL{DomainZone: "example.com"},
+			want:    "example.com",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if got := autoSSLServerName(test.autoSSL); got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCertificateExpiry(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	want := server.Certificate().NotAfter
+
+	got, err := certificateExpiry(context.Background(), server.Listener.Addr().String(), "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestLoadBalancerCollectorSSL(t *testing.T) {
+	tlsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer tlsServer.Close()
+
+	_, port, err := net.SplitHostPort(tlsServer.Listener.Addr().String())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A port on which nothing is listening
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, closed, err := net.SplitHostPort(listener.Addr().String())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	listener.Close() //nolint:errcheck
+
+	responseLoadBalancers := fmt.Sprintf(`{
+		"load_balancers": [
+			{
+				"id": "1317575f-e8a3-4bfb-9d2e-4aaf2f3d8f7c",
+				"region": "ewr",
+				"label": "reachable",
+				"status": "active",
+				"ipv4": "127.0.0.1",
+				"has_ssl": true,
+				"forwarding_rules": [
+					{
+						"frontend_protocol": "https",
+						"frontend_port": %s
+					}
+				]
+			},
+			{
+				"id": "6c0c8c8e-0b8a-4f1e-9c3d-2b1a0f9e8d7c",
+				"region": "ewr",
+				"label": "unreachable",
+				"status": "active",
+				"ipv4": "127.0.0.1",
+				"has_ssl": true,
+				"forwarding_rules": [
+					{
+						"frontend_protocol": "https",
+						"frontend_port": %s
+					}
+				]
+			}
+		],
+		"meta": {
+			"total": 2,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`, port, closed)
+
+	for _, test := range []struct {
+		name     string
+		checkSSL bool
+		want     string
+	}{
+		{
+			name:     "Enabled",
+			checkSSL: true,
+			want: fmt.Sprintf(`
+			# HELP test_load_balancer_ssl_certificate_expiry_time Expiry time of the Load balancer's SSL certificate in Unix epoch seconds
+			# TYPE test_load_balancer_ssl_certificate_expiry_time gauge
+			test_load_balancer_ssl_certificate_expiry_time{label="reachable",region="ewr",server_name=""} %d
+			# HELP test_load_balancer_ssl_probe_success Whether the Load balancer's SSL certificate was retrieved (1=success)
+			# TYPE test_load_balancer_ssl_probe_success gauge
+			test_load_balancer_ssl_probe_success{label="reachable",region="ewr",server_name=""} 1
+			test_load_balancer_ssl_probe_success{label="unreachable",region="ewr",server_name=""} 0
+			`, tlsServer.Certificate().NotAfter.Unix()),
+		},
+		{
+			name:     "Disabled",
+			checkSSL: false,
+			want:     "",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("/v2/load-balancers", func(w http.ResponseWriter, r *http.Request) {
+				if _, err := fmt.Fprint(w, responseLoadBalancers); err != nil {
+					t.Errorf("unable to write response: %v", err)
+				}
+			})
+
+			log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+			log = log.WithName("test")
+
+			s := System{
+				Namespace: tNamespace,
+				Subsystem: tSubsystem,
+				Version:   tVersion,
+			}
+
+			collector := NewLoadBalancerCollector(s, client, test.checkSSL, log)
+
+			if err := testutil.CollectAndCompare(
+				collector,
+				strings.NewReader(test.want),
+				"test_load_balancer_ssl_certificate_expiry_time",
+				"test_load_balancer_ssl_probe_success",
+			); err != nil {
+				t.Errorf("unexpected collecting result:\n%s", err)
+			}
+		})
+	}
+}
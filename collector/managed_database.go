@@ -0,0 +1,258 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+// ManagedDatabaseCollector represents Managed Databases
+type ManagedDatabaseCollector struct {
+	System          System
+	Client          *govultr.Client
+	Log             logr.Logger
+	Up              *prometheus.Desc
+	Nodes           *prometheus.Desc
+	Replicas        *prometheus.Desc
+	DiskUsed        *prometheus.Desc
+	DiskSize        *prometheus.Desc
+	MemoryUsed      *prometheus.Desc
+	MemorySize      *prometheus.Desc
+	CPUUsage        *prometheus.Desc
+	NextMaintenance *prometheus.Desc
+}
+
+// NewManagedDatabaseCollector creates a new ManagedDatabaseCollector
+func NewManagedDatabaseCollector(s System, client *govultr.Client, log logr.Logger) *ManagedDatabaseCollector {
+	subsystem := "managed_database"
+	labelKeys := []string{
+		"id",
+		"label",
+		"region",
+		"plan",
+		"engine",
+		"version",
+	}
+	return &ManagedDatabaseCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Up: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "up"),
+			"Managed Database",
+			append(labelKeys, "status"),
+			nil,
+		),
+		Nodes: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "nodes"),
+			"Number of Nodes (or Kafka brokers)",
+			labelKeys,
+			nil,
+		),
+		Replicas: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "read_replicas"),
+			"Number of Read Replicas",
+			labelKeys,
+			nil,
+		),
+		DiskUsed: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "disk_used"),
+			"Disk (GB) used",
+			labelKeys,
+			nil,
+		),
+		DiskSize: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "disk_size"),
+			"Disk (GB) available",
+			labelKeys,
+			nil,
+		),
+		MemoryUsed: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "memory_used"),
+			"Memory (MB) used",
+			labelKeys,
+			nil,
+		),
+		MemorySize: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "memory_size"),
+			"Memory (MB) available",
+			labelKeys,
+			nil,
+		),
+		CPUUsage: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "cpu_usage_percent"),
+			"Average CPU usage (%)",
+			labelKeys,
+			nil,
+		),
+		NextMaintenance: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "next_maintenance_time"),
+			"Start of the next maintenance window in Unix epoch seconds",
+			labelKeys,
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *ManagedDatabaseCollector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx := context.Background()
+
+	// DBListOptions does not support cursors
+	// The Database service returns all Managed Databases in a single page
+	databases, _, _, err := c.Client.Database.List(ctx, &govultr.DBListOptions{})
+	if err != nil {
+		log.Error(err, "Unable to Database.List")
+		return
+	}
+
+	now := time.Now().UTC()
+
+	// Enumerate all of the databases
+	var wg sync.WaitGroup
+	for _, database := range databases {
+		wg.Add(1)
+		go func(database govultr.Database) {
+			defer wg.Done()
+
+			labelValues := []string{
+				database.ID,
+				database.Label,
+				database.Region,
+				database.Plan,
+				database.DatabaseEngine,
+				database.DatabaseEngineVersion,
+			}
+
+			ch <- prometheus.MustNewConstMetric(
+				c.Up,
+				prometheus.CounterValue,
+				func(status string) (result float64) {
+					if status == "Running" {
+						result = 1.0
+					}
+					return result
+				}(database.Status),
+				append(labelValues, database.Status)...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.Nodes,
+				prometheus.GaugeValue,
+				func(database govultr.Database) float64 {
+					// Kafka clusters are sized by brokers rather than replicas
+					if database.PlanBrokers > 0 {
+						return float64(database.PlanBrokers)
+					}
+					// The primary node plus any standby replicas
+					nodes := 1
+					if database.PlanReplicas != nil {
+						nodes += *database.PlanReplicas
+					}
+					return float64(nodes)
+				}(database),
+				labelValues...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.Replicas,
+				prometheus.GaugeValue,
+				float64(len(database.ReadReplicas)),
+				labelValues...,
+			)
+
+			if next, err := nextMaintenance(database.MaintenanceDOW, database.MaintenanceTime, now); err != nil {
+				log.Info("Unable to determine next maintenance window",
+					"id", database.ID,
+					"dow", database.MaintenanceDOW,
+					"time", database.MaintenanceTime,
+				)
+			} else {
+				ch <- prometheus.MustNewConstMetric(
+					c.NextMaintenance,
+					prometheus.GaugeValue,
+					float64(next.Unix()),
+					labelValues...,
+				)
+			}
+
+			// Usage is not provided for every engine
+			usage, _, err := c.Client.Database.GetUsage(ctx, database.ID)
+			if err != nil {
+				log.Info("Unable to Database.GetUsage",
+					"id", database.ID,
+					"engine", database.DatabaseEngine,
+					"err", err,
+				)
+				return
+			}
+
+			for desc, value := range map[*prometheus.Desc]float64{
+				c.DiskUsed:   float64(usage.Disk.CurrentGB),
+				c.DiskSize:   float64(usage.Disk.MaxGB),
+				c.MemoryUsed: float64(usage.Memory.CurrentMB),
+				c.MemorySize: float64(usage.Memory.MaxMB),
+				c.CPUUsage:   float64(usage.CPU.Percentage),
+			} {
+				ch <- prometheus.MustNewConstMetric(
+					desc,
+					prometheus.GaugeValue,
+					value,
+					labelValues...,
+				)
+			}
+		}(database)
+	}
+	wg.Wait()
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *ManagedDatabaseCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Up
+	ch <- c.Nodes
+	ch <- c.Replicas
+	ch <- c.DiskUsed
+	ch <- c.DiskSize
+	ch <- c.MemoryUsed
+	ch <- c.MemorySize
+	ch <- c.CPUUsage
+	ch <- c.NextMaintenance
+}
+
+// nextMaintenance returns the next occurrence (after now) of a weekly maintenance window
+// The window is defined by a day of the week (e.g. "sunday") and a UTC time (e.g. "02:00:00")
+func nextMaintenance(dow, hms string, now time.Time) (time.Time, error) {
+	weekday := -1
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), dow) {
+			weekday = int(d)
+			break
+		}
+	}
+	if weekday < 0 {
+		return time.Time{}, fmt.Errorf("invalid day of week %q", dow)
+	}
+
+	t, err := time.Parse(time.TimeOnly, hms)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	now = now.UTC()
+	days := (weekday - int(now.Weekday()) + 7) % 7
+	next := time.Date(
+		now.Year(), now.Month(), now.Day()+days,
+		t.Hour(), t.Minute(), t.Second(), 0,
+		time.UTC,
+	)
+	// The window has already started (or passed) today; use next week's
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 7)
+	}
+	return next, nil
+}
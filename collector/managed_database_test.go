@@ -0,0 +1,37 @@
+package collector
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextMaintenance(t *testing.T) {
+	// Wednesday
+	now := time.Date(2024, time.January, 10, 12, 0, 0, 0, time.UTC)
+
+	for _, test := range []struct {
+		name string
+		dow  string
+		hms  string
+		want time.Time
+	}{
+		{"later this week", "sunday", "02:00:00", time.Date(2024, time.January, 14, 2, 0, 0, 0, time.UTC)},
+		{"later today", "wednesday", "13:30:00", time.Date(2024, time.January, 10, 13, 30, 0, 0, time.UTC)},
+		{"earlier today", "Wednesday", "11:00:00", time.Date(2024, time.January, 17, 11, 0, 0, 0, time.UTC)},
+		{"across month", "tuesday", "00:00:00", time.Date(2024, time.January, 16, 0, 0, 0, 0, time.UTC)},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := nextMaintenance(test.dow, test.hms, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+
+	if _, err := nextMaintenance("someday", "02:00:00", now); err == nil {
+		t.Error("expected error for invalid day of week")
+	}
+}
@@ -0,0 +1,202 @@
+package collector
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+// ObjectStorageCollector represents Object Storage
+type ObjectStorageCollector struct {
+	System               System
+	Client               *govultr.Client
+	Log                  logr.Logger
+	Up                   *prometheus.Desc
+	TierPrice            *prometheus.Desc
+	TierDiskGBPrice      *prometheus.Desc
+	TierBandwidthGBPrice *prometheus.Desc
+}
+
+// NewObjectStorageCollector creates a new ObjectStorageCollector
+func NewObjectStorageCollector(s System, client *govultr.Client, log logr.Logger) *ObjectStorageCollector {
+	subsystem := "object_storage"
+	tierLabelKeys := []string{
+		"cluster_id",
+		"hostname",
+		"tier",
+		"default",
+	}
+	return &ObjectStorageCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Up: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "up"),
+			"Object Storage subscription",
+			[]string{
+				"id",
+				"label",
+				"region",
+				"cluster_id",
+				"hostname",
+				"status",
+			},
+			nil,
+		),
+		TierPrice: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "tier_price_usd"),
+			"Monthly price (USD) of an Object Storage tier in a cluster",
+			tierLabelKeys,
+			nil,
+		),
+		TierDiskGBPrice: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "tier_disk_gb_price_usd"),
+			"Price (USD) per GB of storage of an Object Storage tier in a cluster",
+			tierLabelKeys,
+			nil,
+		),
+		TierBandwidthGBPrice: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "tier_bandwidth_gb_price_usd"),
+			"Price (USD) per GB of bandwidth of an Object Storage tier in a cluster",
+			tierLabelKeys,
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *ObjectStorageCollector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx := context.Background()
+
+	// Get all Object Storage clusters
+	// These are used to resolve a subscription's cluster ID to its hostname
+	// ListCluster ignores ListOptions and returns all clusters in a single page
+	allClusters, _, _, err := c.Client.ObjectStorage.ListCluster(ctx, nil)
+	if err != nil {
+		log.Error(err, "Unable to ObjectStorage.ListCluster")
+		return
+	}
+
+	clusters := make(map[int]govultr.ObjectStorageCluster)
+	for _, cluster := range allClusters {
+		clusters[cluster.ID] = cluster
+	}
+
+	// Get all Object Storage subscriptions across all pages
+	var allSubscriptions []govultr.ObjectStorage
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		subscriptions, meta, _, err := c.Client.ObjectStorage.List(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to ObjectStorage.List")
+			return
+		}
+
+		allSubscriptions = append(allSubscriptions, subscriptions...)
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	// Enumerate the subscriptions
+	// Retaining the (distinct) clusters that are in use
+	inUse := make(map[int]govultr.ObjectStorageCluster)
+	for _, subscription := range allSubscriptions {
+		cluster, ok := clusters[subscription.ObjectStoreClusterID]
+		if !ok {
+			// Fallback to the subscription's own details
+			cluster = govultr.ObjectStorageCluster{
+				ID:       subscription.ObjectStoreClusterID,
+				Region:   subscription.Region,
+				Hostname: subscription.S3Hostname,
+			}
+		}
+		inUse[cluster.ID] = cluster
+
+		ch <- prometheus.MustNewConstMetric(
+			c.Up,
+			prometheus.CounterValue,
+			func(status string) (result float64) {
+				if status == "active" {
+					result = 1.0
+				}
+				return result
+			}(subscription.Status),
+			[]string{
+				subscription.ID,
+				subscription.Label,
+				subscription.Region,
+				strconv.Itoa(cluster.ID),
+				cluster.Hostname,
+				subscription.Status,
+			}...,
+		)
+	}
+
+	// Enumerate the tiers available in each cluster that is in use
+	// The Vultr API does not associate a subscription with its tier
+	var wg sync.WaitGroup
+	for _, cluster := range inUse {
+		wg.Add(1)
+		go func(cluster govultr.ObjectStorageCluster) {
+			defer wg.Done()
+
+			tiers, _, err := c.Client.ObjectStorage.ListClusterTiers(ctx, cluster.ID)
+			if err != nil {
+				log.Error(err, "Unable to ObjectStorage.ListClusterTiers",
+					"cluster_id", cluster.ID,
+				)
+				return
+			}
+
+			for _, tier := range tiers {
+				labelValues := []string{
+					strconv.Itoa(cluster.ID),
+					cluster.Hostname,
+					tier.Slug,
+					tier.Default,
+				}
+				ch <- prometheus.MustNewConstMetric(
+					c.TierPrice,
+					prometheus.GaugeValue,
+					float64(tier.Price),
+					labelValues...,
+				)
+				ch <- prometheus.MustNewConstMetric(
+					c.TierDiskGBPrice,
+					prometheus.GaugeValue,
+					float64(tier.PriceDiskGB),
+					labelValues...,
+				)
+				ch <- prometheus.MustNewConstMetric(
+					c.TierBandwidthGBPrice,
+					prometheus.GaugeValue,
+					float64(tier.PriceBandwidthGB),
+					labelValues...,
+				)
+			}
+		}(cluster)
+	}
+	wg.Wait()
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *ObjectStorageCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Up
+	ch <- c.TierPrice
+	ch <- c.TierDiskGBPrice
+	ch <- c.TierBandwidthGBPrice
+}
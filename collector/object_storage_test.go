@@ -0,0 +1,168 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	responseObjectStorageClusters string = `{
+		"clusters": [
+			{
+				"id": 2,
+				"region": "ewr",
+				"hostname": "ewr1.vultrobjects.com",
+				"deploy": "yes"
+			},
+			{
+				"id": 4,
+				"region": "ams",
+				"hostname": "ams1.vultrobjects.com",
+				"deploy": "yes"
+			}
+		],
+		"meta": {
+			"total": 2,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	// Two subscriptions share cluster 2
+	// Cluster 9 is not listed; its hostname is taken from the subscription
+	responseObjectStorages string = `{
+		"object_storages": [
+			{
+				"id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+				"cluster_id": 2,
+				"region": "ewr",
+				"label": "my-bucket",
+				"status": "active",
+				"s3_hostname": "ewr1.vultrobjects.com"
+			},
+			{
+				"id": "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
+				"cluster_id": 2,
+				"region": "ewr",
+				"label": "my-other-bucket",
+				"status": "pending",
+				"s3_hostname": "ewr1.vultrobjects.com"
+			},
+			{
+				"id": "3f26dfe9-6a18-4f3d-a543-0cbca7b3e496",
+				"cluster_id": 9,
+				"region": "sjc",
+				"label": "my-new-bucket",
+				"status": "active",
+				"s3_hostname": "sjc1.vultrobjects.com"
+			}
+		],
+		"meta": {
+			"total": 3,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseObjectStorageTiers string = `{
+		"tiers": [
+			{
+				"id": 1,
+				"sales_name": "Standard",
+				"price": 18,
+				"bw_gb_price": 0.0078125,
+				"disk_gb_price": 0.015625,
+				"is_default": "yes",
+				"slug": "tier_010k_5000m"
+			}
+		]
+	}`
+	prometheusObjectStorage string = `
+	# HELP test_object_storage_tier_bandwidth_gb_price_usd Price (USD) per GB of bandwidth of an Object Storage tier in a cluster
+	# TYPE test_object_storage_tier_bandwidth_gb_price_usd gauge
+	test_object_storage_tier_bandwidth_gb_price_usd{cluster_id="2",default="yes",hostname="ewr1.vultrobjects.com",tier="tier_010k_5000m"} 0.0078125
+	test_object_storage_tier_bandwidth_gb_price_usd{cluster_id="9",default="yes",hostname="sjc1.vultrobjects.com",tier="tier_010k_5000m"} 0.0078125
+	# HELP test_object_storage_tier_disk_gb_price_usd Price (USD) per GB of storage of an Object Storage tier in a cluster
+	# TYPE test_object_storage_tier_disk_gb_price_usd gauge
+	test_object_storage_tier_disk_gb_price_usd{cluster_id="2",default="yes",hostname="ewr1.vultrobjects.com",tier="tier_010k_5000m"} 0.015625
+	test_object_storage_tier_disk_gb_price_usd{cluster_id="9",default="yes",hostname="sjc1.vultrobjects.com",tier="tier_010k_5000m"} 0.015625
+	# HELP test_object_storage_tier_price_usd Monthly price (USD) of an Object Storage tier in a cluster
+	# TYPE test_object_storage_tier_price_usd gauge
+	test_object_storage_tier_price_usd{cluster_id="2",default="yes",hostname="ewr1.vultrobjects.com",tier="tier_010k_5000m"} 18
+	test_object_storage_tier_price_usd{cluster_id="9",default="yes",hostname="sjc1.vultrobjects.com",tier="tier_010k_5000m"} 18
+	# HELP test_object_storage_up Object Storage subscription
+	# TYPE test_object_storage_up counter
+	test_object_storage_up{cluster_id="2",hostname="ewr1.vultrobjects.com",id="14b3e7d6-ffb5-4994-8502-57fcd9db3b33",label="my-other-bucket",region="ewr",status="pending"} 0
+	test_object_storage_up{cluster_id="2",hostname="ewr1.vultrobjects.com",id="cb676a46-66fd-4dfb-b839-443f2e6c0b60",label="my-bucket",region="ewr",status="active"} 1
+	test_object_storage_up{cluster_id="9",hostname="sjc1.vultrobjects.com",id="3f26dfe9-6a18-4f3d-a543-0cbca7b3e496",label="my-new-bucket",region="sjc",status="active"} 1
+	`
+)
+
+func TestObjectStorageCollector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for path, response := range map[string]string{
+		"/v2/object-storage/clusters": responseObjectStorageClusters,
+		"/v2/object-storage":          responseObjectStorages,
+	} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if _, err := fmt.Fprint(w, response); err != nil {
+				t.Errorf("unable to write response: %v", err)
+			}
+		})
+	}
+
+	// Tiers are listed once for each cluster that is in use
+	tiers := map[string]*atomic.Int32{
+		"2": {},
+		"4": {},
+		"9": {},
+	}
+	for id, calls := range tiers {
+		mux.HandleFunc(fmt.Sprintf("/v2/object-storage/clusters/%s/tiers", id), func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			if _, err := fmt.Fprint(w, responseObjectStorageTiers); err != nil {
+				t.Errorf("unable to write response: %v", err)
+			}
+		})
+	}
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	collector := NewObjectStorageCollector(s, client, log)
+
+	if err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(prometheusObjectStorage),
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	for id, want := range map[string]int32{
+		"2": 1,
+		"4": 0,
+		"9": 1,
+	} {
+		if got := tiers[id].Load(); got != want {
+			t.Errorf("cluster %s: got %d tier requests, want %d", id, got, want)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package collector
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+// PlanCollector represents the catalog of Plans (including Bare Metal Plans)
+type PlanCollector struct {
+	System      System
+	Client      *govultr.Client
+	Log         logr.Logger
+	MonthlyCost *prometheus.Desc
+	HourlyCost  *prometheus.Desc
+	Available   *prometheus.Desc
+}
+
+// NewPlanCollector creates a new PlanCollector
+func NewPlanCollector(s System, client *govultr.Client, log logr.Logger) *PlanCollector {
+	subsystem := "plan"
+	labelKeys := []string{
+		"id",
+		"type",
+		"vcpu",
+		"ram",
+		"disk",
+		"bandwidth",
+	}
+	return &PlanCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		MonthlyCost: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "monthly_cost_usd"),
+			"List price (USD) of Plan per month",
+			labelKeys,
+			nil,
+		),
+		HourlyCost: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "hourly_cost_usd"),
+			"List price (USD) of Plan per hour",
+			labelKeys,
+			nil,
+		),
+		Available: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "available"),
+			"Whether the Plan is available in the region (1=available)",
+			[]string{
+				"id",
+				"type",
+				"region",
+			},
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *PlanCollector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx := context.Background()
+
+	// Get all Plans across all pages
+	options := &govultr.ListOptions{
+		PerPage: 500,
+	}
+
+	for {
+		plans, meta, _, err := c.Client.Plan.List(ctx, "", options)
+		if err != nil {
+			log.Error(err, "Unable to Plan.List")
+			break
+		}
+
+		for _, plan := range plans {
+			c.collect(ch, plan.ID, plan.Type, plan.VCPUCount, plan.RAM, plan.Disk, plan.Bandwidth, plan.MonthlyCost, plan.Locations)
+		}
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	// Get all Bare Metal Plans across all pages
+	options = &govultr.ListOptions{
+		PerPage: 500,
+	}
+
+	for {
+		plans, meta, _, err := c.Client.Plan.ListBareMetal(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to Plan.ListBareMetal")
+			break
+		}
+
+		// Bare Metal Plans report (physical) CPUs rather than vCPUs
+		for _, plan := range plans {
+			c.collect(ch, plan.ID, plan.Type, plan.CPUCount, plan.RAM, plan.Disk, plan.Bandwidth, plan.MonthlyCost, plan.Locations)
+		}
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+}
+
+// collect emits the metrics for a single Plan
+// Plans and Bare Metal Plans are distinct types but share these fields
+func (c *PlanCollector) collect(ch chan<- prometheus.Metric, id, planType string, vcpu, ram, disk, bandwidth int, monthlyCost float32, locations []string) {
+	labelValues := []string{
+		id,
+		planType,
+		strconv.Itoa(vcpu),
+		strconv.Itoa(ram),
+		strconv.Itoa(disk),
+		strconv.Itoa(bandwidth),
+	}
+
+	ch <- prometheus.MustNewConstMetric(
+		c.MonthlyCost,
+		prometheus.GaugeValue,
+		float64(monthlyCost),
+		labelValues...,
+	)
+	// The Vultr API does not include hourly prices
+	// These are derived from the monthly price
+	ch <- prometheus.MustNewConstMetric(
+		c.HourlyCost,
+		prometheus.GaugeValue,
+		float64(monthlyCost)/hoursPerMonth,
+		labelValues...,
+	)
+
+	for _, location := range locations {
+		ch <- prometheus.MustNewConstMetric(
+			c.Available,
+			prometheus.GaugeValue,
+			1.0,
+			[]string{
+				id,
+				planType,
+				location,
+			}...,
+		)
+	}
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *PlanCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.MonthlyCost
+	ch <- c.HourlyCost
+	ch <- c.Available
+}
@@ -0,0 +1,111 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	responsePlans string = `{
+		"plans": [
+			{
+				"id": "vc2-1c-1gb",
+				"vcpu_count": 1,
+				"ram": 1024,
+				"disk": 25,
+				"disk_count": 1,
+				"bandwidth": 1024,
+				"monthly_cost": 5,
+				"type": "vc2",
+				"locations": ["ewr", "lax"]
+			}
+		],
+		"meta": {
+			"total": 1,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responsePlansMetal string = `{
+		"plans_metal": [
+			{
+				"id": "vbm-4c-32gb",
+				"cpu_count": 4,
+				"cpu_model": "E3-1270v6",
+				"cpu_threads": 8,
+				"ram": 32768,
+				"disk": 240,
+				"disk_count": 2,
+				"bandwidth": 5120,
+				"monthly_cost": 146,
+				"type": "SSD",
+				"locations": ["ewr"]
+			}
+		],
+		"meta": {
+			"total": 1,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	prometheusPlan string = `
+	# HELP test_plan_available Whether the Plan is available in the region (1=available)
+	# TYPE test_plan_available gauge
+	test_plan_available{id="vbm-4c-32gb",region="ewr",type="SSD"} 1
+	test_plan_available{id="vc2-1c-1gb",region="ewr",type="vc2"} 1
+	test_plan_available{id="vc2-1c-1gb",region="lax",type="vc2"} 1
+	# HELP test_plan_hourly_cost_usd List price (USD) of Plan per hour
+	# TYPE test_plan_hourly_cost_usd gauge
+	test_plan_hourly_cost_usd{bandwidth="1024",disk="25",id="vc2-1c-1gb",ram="1024",type="vc2",vcpu="1"} 0.00684931506849315
+	test_plan_hourly_cost_usd{bandwidth="5120",disk="240",id="vbm-4c-32gb",ram="32768",type="SSD",vcpu="4"} 0.2
+	# HELP test_plan_monthly_cost_usd List price (USD) of Plan per month
+	# TYPE test_plan_monthly_cost_usd gauge
+	test_plan_monthly_cost_usd{bandwidth="1024",disk="25",id="vc2-1c-1gb",ram="1024",type="vc2",vcpu="1"} 5
+	test_plan_monthly_cost_usd{bandwidth="5120",disk="240",id="vbm-4c-32gb",ram="32768",type="SSD",vcpu="4"} 146
+	`
+)
+
+func TestPlanCollector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for path, response := range map[string]string{
+		"/v2/plans":       responsePlans,
+		"/v2/plans-metal": responsePlansMetal,
+	} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if _, err := fmt.Fprint(w, response); err != nil {
+				t.Errorf("unable to write response: %v", err)
+			}
+		})
+	}
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	collector := NewPlanCollector(s, client, log)
+
+	if err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(prometheusPlan),
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
@@ -0,0 +1,179 @@
+package collector
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+const (
+	// regionAvailabilityConcurrency is the maximum number of concurrent queries for Regions' availability
+	regionAvailabilityConcurrency = 10
+)
+
+// RegionCollector represents Regions and the Plans and features available in them
+type RegionCollector struct {
+	System         System
+	Client         *govultr.Client
+	Log            logr.Logger
+	Info           *prometheus.Desc
+	Feature        *prometheus.Desc
+	AvailablePlans *prometheus.Desc
+	PlanAvailable  *prometheus.Desc
+}
+
+// NewRegionCollector creates a new RegionCollector
+func NewRegionCollector(s System, client *govultr.Client, log logr.Logger) *RegionCollector {
+	subsystem := "region"
+	return &RegionCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Info: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "info"),
+			"A metric with a constant '1' value labeled by the Region's city, country and continent",
+			[]string{
+				"region",
+				"city",
+				"country",
+				"continent",
+			},
+			nil,
+		),
+		Feature: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "feature"),
+			"A metric with a constant '1' value for each feature (e.g. block storage, DDoS protection, Kubernetes) offered in the Region",
+			[]string{
+				"region",
+				"feature",
+			},
+			nil,
+		),
+		AvailablePlans: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "available_plans"),
+			"Number of Plans currently available in the Region",
+			[]string{
+				"region",
+			},
+			nil,
+		),
+		PlanAvailable: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "plan_available"),
+			"A metric with a constant '1' value for each Plan currently available in the Region",
+			[]string{
+				"region",
+				"plan",
+			},
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *RegionCollector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx := context.Background()
+
+	// Get all Regions across all pages
+	var allRegions []govultr.Region
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		regions, meta, _, err := c.Client.Region.List(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to Region.List")
+			return
+		}
+
+		allRegions = append(allRegions, regions...)
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	// Regions are queried for their availability individually
+	// The number of concurrent queries is bounded to avoid Vultr's API rate limit
+	sem := make(chan struct{}, regionAvailabilityConcurrency)
+
+	// Enumerate the Regions
+	var wg sync.WaitGroup
+	for _, region := range allRegions {
+		wg.Add(1)
+		go func(region govultr.Region) {
+			defer wg.Done()
+
+			ch <- prometheus.MustNewConstMetric(
+				c.Info,
+				prometheus.GaugeValue,
+				1.0,
+				[]string{
+					region.ID,
+					region.City,
+					region.Country,
+					region.Continent,
+				}...,
+			)
+			for _, option := range region.Options {
+				ch <- prometheus.MustNewConstMetric(
+					c.Feature,
+					prometheus.GaugeValue,
+					1.0,
+					[]string{
+						region.ID,
+						option,
+					}...,
+				)
+			}
+
+			// Availability reflects the Plans that may currently be deployed in the Region
+			// A Plan that is sold out is not included
+			sem <- struct{}{}
+			availability, _, err := c.Client.Region.Availability(ctx, region.ID, "")
+			<-sem
+			if err != nil {
+				log.Error(err, "Unable to Region.Availability",
+					"region", region.ID,
+				)
+				return
+			}
+			ch <- prometheus.MustNewConstMetric(
+				c.AvailablePlans,
+				prometheus.GaugeValue,
+				float64(len(availability.AvailablePlans)),
+				[]string{
+					region.ID,
+				}...,
+			)
+			for _, plan := range availability.AvailablePlans {
+				ch <- prometheus.MustNewConstMetric(
+					c.PlanAvailable,
+					prometheus.GaugeValue,
+					1.0,
+					[]string{
+						region.ID,
+						plan,
+					}...,
+				)
+			}
+		}(region)
+	}
+	wg.Wait()
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *RegionCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Info
+	ch <- c.Feature
+	ch <- c.AvailablePlans
+	ch <- c.PlanAvailable
+}
@@ -0,0 +1,101 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	responseRegions string = `{
+		"regions": [
+			{
+				"id": "ewr",
+				"city": "New Jersey",
+				"country": "US",
+				"continent": "North America",
+				"options": ["ddos_protection", "block_storage_high_perf", "kubernetes"]
+			},
+			{
+				"id": "lax",
+				"city": "Los Angeles",
+				"country": "US",
+				"continent": "North America",
+				"options": []
+			}
+		],
+		"meta": {
+			"total": 2,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseRegionAvailabilityEWR string = `{
+		"available_plans": ["vc2-1c-1gb", "vc2-2c-4gb"]
+	}`
+	responseRegionAvailabilityLAX string = `{
+		"available_plans": []
+	}`
+	prometheusRegion string = `
+	# HELP test_region_available_plans Number of Plans currently available in the Region
+	# TYPE test_region_available_plans gauge
+	test_region_available_plans{region="ewr"} 2
+	test_region_available_plans{region="lax"} 0
+	# HELP test_region_feature A metric with a constant '1' value for each feature (e.g. block storage, DDoS protection, Kubernetes) offered in the Region
+	# TYPE test_region_feature gauge
+	test_region_feature{feature="block_storage_high_perf",region="ewr"} 1
+	test_region_feature{feature="ddos_protection",region="ewr"} 1
+	test_region_feature{feature="kubernetes",region="ewr"} 1
+	# HELP test_region_info A metric with a constant '1' value labeled by the Region's city, country and continent
+	# TYPE test_region_info gauge
+	test_region_info{city="Los Angeles",continent="North America",country="US",region="lax"} 1
+	test_region_info{city="New Jersey",continent="North America",country="US",region="ewr"} 1
+	# HELP test_region_plan_available A metric with a constant '1' value for each Plan currently available in the Region
+	# TYPE test_region_plan_available gauge
+	test_region_plan_available{plan="vc2-1c-1gb",region="ewr"} 1
+	test_region_plan_available{plan="vc2-2c-4gb",region="ewr"} 1
+	`
+)
+
+func TestRegionCollector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for path, response := range map[string]string{
+		"/v2/regions":                  responseRegions,
+		"/v2/regions/ewr/availability": responseRegionAvailabilityEWR,
+		"/v2/regions/lax/availability": responseRegionAvailabilityLAX,
+	} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if _, err := fmt.Fprint(w, response); err != nil {
+				t.Errorf("unable to write response: %v", err)
+			}
+		})
+	}
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	collector := NewRegionCollector(s, client, log)
+
+	if err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(prometheusRegion),
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
@@ -12,10 +12,11 @@ import (
 
 // ReservedIPsCollector represents Reserved IPs
 type ReservedIPsCollector struct {
-	System System
-	Client *govultr.Client
-	Log    logr.Logger
-	Up     *prometheus.Desc
+	System   System
+	Client   *govultr.Client
+	Log      logr.Logger
+	Up       *prometheus.Desc
+	Attached *prometheus.Desc
 }
 
 // NewReservedIPsCollector creates a new ResevedIPsCollector
@@ -36,6 +37,19 @@ func NewReservedIPsCollector(s System, client *govultr.Client, log logr.Logger)
 			},
 			nil,
 		),
+		Attached: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "attached"),
+			"Whether Reserved IPs are attached to an Instance (1=attached)",
+			[]string{
+				"id",
+				"region",
+				"type",
+				"subnet",
+				"label",
+				"instance_id",
+			},
+			nil,
+		),
 	}
 }
 
@@ -86,6 +100,24 @@ func (c *ReservedIPsCollector) Collect(ch chan<- prometheus.Metric) {
 					ip.Label,
 				}...,
 			)
+			ch <- prometheus.MustNewConstMetric(
+				c.Attached,
+				prometheus.GaugeValue,
+				func(instanceID string) (result float64) {
+					if instanceID != "" {
+						result = 1.0
+					}
+					return result
+				}(ip.InstanceID),
+				[]string{
+					ip.ID,
+					ip.Region,
+					ip.IPType,
+					ip.Subnet,
+					ip.Label,
+					ip.InstanceID,
+				}...,
+			)
 		}(ip)
 	}
 	wg.Wait()
@@ -94,4 +126,5 @@ func (c *ReservedIPsCollector) Collect(ch chan<- prometheus.Metric) {
 // Describe implements Prometheus' Collector interface and is used to describe metrics
 func (c *ReservedIPsCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- c.Up
+	ch <- c.Attached
 }
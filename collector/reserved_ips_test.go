@@ -0,0 +1,84 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	responseReservedIPs string = `{
+		"reserved_ips": [
+			{
+				"id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+				"region": "ewr",
+				"ip_type": "v4",
+				"subnet": "192.0.2.1",
+				"subnet_size": 32,
+				"label": "attached",
+				"instance_id": "3f26dfe9-6a18-4f3d-a543-0cbca7b3e496"
+			},
+			{
+				"id": "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
+				"region": "ewr",
+				"ip_type": "v4",
+				"subnet": "192.0.2.2",
+				"subnet_size": 32,
+				"label": "unattached",
+				"instance_id": ""
+			}
+		],
+		"meta": {
+			"total": 2,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	prometheusReservedIPs string = `
+	# HELP test_reserved_ips_attached Whether Reserved IPs are attached to an Instance (1=attached)
+	# TYPE test_reserved_ips_attached gauge
+	test_reserved_ips_attached{id="14b3e7d6-ffb5-4994-8502-57fcd9db3b33",instance_id="",label="unattached",region="ewr",subnet="192.0.2.2",type="v4"} 0
+	test_reserved_ips_attached{id="cb676a46-66fd-4dfb-b839-443f2e6c0b60",instance_id="3f26dfe9-6a18-4f3d-a543-0cbca7b3e496",label="attached",region="ewr",subnet="192.0.2.1",type="v4"} 1
+	# HELP test_reserved_ips_up Reserved IPs
+	# TYPE test_reserved_ips_up counter
+	test_reserved_ips_up{label="attached",region="ewr",subnet_size="32",type="v4"} 1
+	test_reserved_ips_up{label="unattached",region="ewr",subnet_size="32",type="v4"} 1
+	`
+)
+
+func TestReservedIPsCollector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/reserved-ips", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := fmt.Fprint(w, responseReservedIPs); err != nil {
+			t.Errorf("unable to write response: %v", err)
+		}
+	})
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	collector := NewReservedIPsCollector(s, client, log)
+
+	if err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(prometheusReservedIPs),
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
@@ -0,0 +1,152 @@
+package collector
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+// SnapshotCollector represents Snapshots
+type SnapshotCollector struct {
+	System         System
+	Client         *govultr.Client
+	Log            logr.Logger
+	Up             *prometheus.Desc
+	Size           *prometheus.Desc
+	CompressedSize *prometheus.Desc
+	Created        *prometheus.Desc
+}
+
+// NewSnapshotCollector creates a new SnapshotCollector
+func NewSnapshotCollector(s System, client *govultr.Client, log logr.Logger) *SnapshotCollector {
+	subsystem := "snapshot"
+	labelKeys := []string{
+		"id",
+		"description",
+		"status",
+	}
+	return &SnapshotCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Up: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "up"),
+			"Snapshot",
+			labelKeys,
+			nil,
+		),
+		Size: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "size_bytes"),
+			"Size (bytes) of Snapshot",
+			labelKeys,
+			nil,
+		),
+		CompressedSize: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "compressed_size_bytes"),
+			"Compressed size (bytes) of Snapshot",
+			labelKeys,
+			nil,
+		),
+		Created: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "created_time"),
+			"Snapshot creation time in Unix epoch seconds",
+			labelKeys,
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *SnapshotCollector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx := context.Background()
+
+	// Get all snapshots across all pages
+	var allSnapshots []govultr.Snapshot
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		snapshots, meta, _, err := c.Client.Snapshot.List(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to Snapshot.List")
+			return
+		}
+
+		allSnapshots = append(allSnapshots, snapshots...)
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	// Enumerate the snapshots
+	var wg sync.WaitGroup
+	for _, snapshot := range allSnapshots {
+		wg.Add(1)
+		go func(snapshot govultr.Snapshot) {
+			defer wg.Done()
+
+			labelValues := []string{
+				snapshot.ID,
+				snapshot.Description,
+				snapshot.Status,
+			}
+
+			ch <- prometheus.MustNewConstMetric(
+				c.Up,
+				prometheus.CounterValue,
+				func(status string) (result float64) {
+					if status == "complete" {
+						result = 1.0
+					}
+					return result
+				}(snapshot.Status),
+				labelValues...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.Size,
+				prometheus.GaugeValue,
+				float64(snapshot.Size),
+				labelValues...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.CompressedSize,
+				prometheus.GaugeValue,
+				float64(snapshot.CompressedSize),
+				labelValues...,
+			)
+
+			created, err := parseTime(snapshot.DateCreated)
+			if err != nil {
+				log.Error(err, "Unable to parse Snapshot creation time",
+					"id", snapshot.ID,
+				)
+				return
+			}
+			ch <- prometheus.MustNewConstMetric(
+				c.Created,
+				prometheus.GaugeValue,
+				float64(created.Unix()),
+				labelValues...,
+			)
+		}(snapshot)
+	}
+	wg.Wait()
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *SnapshotCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Up
+	ch <- c.Size
+	ch <- c.CompressedSize
+	ch <- c.Created
+}
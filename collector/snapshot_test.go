@@ -0,0 +1,90 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	responseSnapshots string = `{
+		"snapshots": [
+			{
+				"id": "3c5e7a9b-1d2f-4e6a-8b0c-2d4f6a8c0e1b",
+				"date_created": "2024-01-15T00:00:00+00:00",
+				"description": "my-snapshot",
+				"size": 26843545600,
+				"compressed_size": 4294967296,
+				"status": "complete"
+			},
+			{
+				"id": "9f1b3d5e-7a2c-4f8b-a6d0-4e2a8c6b0d3f",
+				"date_created": "",
+				"description": "my-pending-snapshot",
+				"size": 0,
+				"compressed_size": 0,
+				"status": "pending"
+			}
+		],
+		"meta": {
+			"total": 2,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	prometheusSnapshot string = `
+	# HELP test_snapshot_compressed_size_bytes Compressed size (bytes) of Snapshot
+	# TYPE test_snapshot_compressed_size_bytes gauge
+	test_snapshot_compressed_size_bytes{description="my-pending-snapshot",id="9f1b3d5e-7a2c-4f8b-a6d0-4e2a8c6b0d3f",status="pending"} 0
+	test_snapshot_compressed_size_bytes{description="my-snapshot",id="3c5e7a9b-1d2f-4e6a-8b0c-2d4f6a8c0e1b",status="complete"} 4.294967296e+09
+	# HELP test_snapshot_created_time Snapshot creation time in Unix epoch seconds
+	# TYPE test_snapshot_created_time gauge
+	test_snapshot_created_time{description="my-snapshot",id="3c5e7a9b-1d2f-4e6a-8b0c-2d4f6a8c0e1b",status="complete"} 1.7052768e+09
+	# HELP test_snapshot_size_bytes Size (bytes) of Snapshot
+	# TYPE test_snapshot_size_bytes gauge
+	test_snapshot_size_bytes{description="my-pending-snapshot",id="9f1b3d5e-7a2c-4f8b-a6d0-4e2a8c6b0d3f",status="pending"} 0
+	test_snapshot_size_bytes{description="my-snapshot",id="3c5e7a9b-1d2f-4e6a-8b0c-2d4f6a8c0e1b",status="complete"} 2.68435456e+10
+	# HELP test_snapshot_up Snapshot
+	# TYPE test_snapshot_up counter
+	test_snapshot_up{description="my-pending-snapshot",id="9f1b3d5e-7a2c-4f8b-a6d0-4e2a8c6b0d3f",status="pending"} 0
+	test_snapshot_up{description="my-snapshot",id="3c5e7a9b-1d2f-4e6a-8b0c-2d4f6a8c0e1b",status="complete"} 1
+	`
+)
+
+func TestSnapshotCollector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := fmt.Fprint(w, responseSnapshots); err != nil {
+			t.Errorf("unable to write response: %v", err)
+		}
+	})
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	collector := NewSnapshotCollector(s, client, log)
+
+	// Snapshots whose creation time can't be parsed have no snapshot_created_time
+	if err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(prometheusSnapshot),
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
@@ -0,0 +1,23 @@
+package collector
+
+import (
+	"fmt"
+	"time"
+)
+
+// layouts are the date-time formats used by the Vultr API
+// Most resources use RFC3339 but some (e.g. snapshots, backups) omit the "T" and timezone
+var layouts = []string{
+	time.RFC3339,
+	time.DateTime,
+}
+
+// parseTime parses a Vultr API date-time string
+func parseTime(s string) (time.Time, error) {
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unable to parse time %q", s)
+}
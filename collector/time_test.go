@@ -0,0 +1,27 @@
+package collector
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	for got, want := range map[string]time.Time{
+		"2020-10-10T01:56:20+00:00": time.Date(2020, time.October, 10, 1, 56, 20, 0, time.UTC),
+		"2014-04-18 12:40:40":       time.Date(2014, time.April, 18, 12, 40, 40, 0, time.UTC),
+	} {
+		t.Run(got, func(t *testing.T) {
+			got, err := parseTime(got)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+
+	if _, err := parseTime("yesterday"); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,156 @@
+package collector
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+// VirtualFileSystemCollector represents Virtual File System (VFS) Storage
+type VirtualFileSystemCollector struct {
+	System      System
+	Client      *govultr.Client
+	Log         logr.Logger
+	Up          *prometheus.Desc
+	Size        *prometheus.Desc
+	Used        *prometheus.Desc
+	Attachments *prometheus.Desc
+}
+
+// NewVirtualFileSystemCollector creates a new VirtualFileSystemCollector
+func NewVirtualFileSystemCollector(s System, client *govultr.Client, log logr.Logger) *VirtualFileSystemCollector {
+	subsystem := "virtual_file_system"
+	labelKeys := []string{
+		"id",
+		"label",
+		"region",
+		"status",
+		"disk_type",
+	}
+	return &VirtualFileSystemCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Up: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "up"),
+			"Virtual File System",
+			labelKeys,
+			nil,
+		),
+		Size: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "size"),
+			"Size (GB) of Virtual File System",
+			labelKeys,
+			nil,
+		),
+		Used: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "used_bytes"),
+			"Storage (bytes) used by Virtual File System",
+			labelKeys,
+			nil,
+		),
+		Attachments: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "attachments"),
+			"Number of Instances attached to the Virtual File System",
+			labelKeys,
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *VirtualFileSystemCollector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx := context.Background()
+
+	// Get all VFS volumes across all pages
+	var allVolumes []govultr.VirtualFileSystemStorage
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		volumes, meta, _, err := c.Client.VirtualFileSystemStorage.List(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to VirtualFileSystemStorage.List")
+			return
+		}
+
+		allVolumes = append(allVolumes, volumes...)
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	// Enumerate the volumes
+	var wg sync.WaitGroup
+	for _, volume := range allVolumes {
+		wg.Add(1)
+		go func(volume govultr.VirtualFileSystemStorage) {
+			defer wg.Done()
+
+			labelValues := []string{
+				volume.ID,
+				volume.Label,
+				volume.Region,
+				volume.Status,
+				volume.DiskType,
+			}
+
+			ch <- prometheus.MustNewConstMetric(
+				c.Up,
+				prometheus.CounterValue,
+				func(status string) (result float64) {
+					if status == "active" {
+						result = 1.0
+					}
+					return result
+				}(volume.Status),
+				labelValues...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.Size,
+				prometheus.GaugeValue,
+				float64(volume.StorageSize.SizeGB),
+				labelValues...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.Used,
+				prometheus.GaugeValue,
+				float64(volume.StorageUsed.SizeBytes),
+				labelValues...,
+			)
+
+			attachments, _, err := c.Client.VirtualFileSystemStorage.AttachmentList(ctx, volume.ID)
+			if err != nil {
+				log.Error(err, "Unable to VirtualFileSystemStorage.AttachmentList",
+					"id", volume.ID,
+				)
+				return
+			}
+			ch <- prometheus.MustNewConstMetric(
+				c.Attachments,
+				prometheus.GaugeValue,
+				float64(len(attachments)),
+				labelValues...,
+			)
+		}(volume)
+	}
+	wg.Wait()
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *VirtualFileSystemCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Up
+	ch <- c.Size
+	ch <- c.Used
+	ch <- c.Attachments
+}
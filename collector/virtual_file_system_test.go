@@ -0,0 +1,126 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	responseVirtualFileSystems string = `{
+		"vfs": [
+			{
+				"id": "5b1d3f7a-2c4e-4b6d-8f0a-1c3e5a7b9d2f",
+				"region": "ewr",
+				"status": "active",
+				"label": "my-vfs",
+				"disk_type": "nvme",
+				"storage_size": {
+					"gb": 100
+				},
+				"storage_used": {
+					"bytes": 1048576,
+					"gb": 0
+				}
+			},
+			{
+				"id": "8e0a2c4d-6f1b-4d3e-9a5c-7b9d1f3e5a7c",
+				"region": "ewr",
+				"status": "pending",
+				"label": "my-other-vfs",
+				"disk_type": "nvme",
+				"storage_size": {
+					"gb": 50
+				},
+				"storage_used": {
+					"gb": 0
+				}
+			}
+		],
+		"meta": {
+			"total": 2,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseVirtualFileSystemAttachments string = `{
+		"attachments": [
+			{
+				"vfs_id": "5b1d3f7a-2c4e-4b6d-8f0a-1c3e5a7b9d2f",
+				"state": "ATTACHED",
+				"target_id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+				"mount_tag": 1
+			},
+			{
+				"vfs_id": "5b1d3f7a-2c4e-4b6d-8f0a-1c3e5a7b9d2f",
+				"state": "ATTACHED",
+				"target_id": "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
+				"mount_tag": 2
+			}
+		]
+	}`
+	responseVirtualFileSystemAttachmentsNone string = `{
+		"attachments": []
+	}`
+	prometheusVirtualFileSystem string = `
+	# HELP test_virtual_file_system_attachments Number of Instances attached to the Virtual File System
+	# TYPE test_virtual_file_system_attachments gauge
+	test_virtual_file_system_attachments{disk_type="nvme",id="5b1d3f7a-2c4e-4b6d-8f0a-1c3e5a7b9d2f",label="my-vfs",region="ewr",status="active"} 2
+	test_virtual_file_system_attachments{disk_type="nvme",id="8e0a2c4d-6f1b-4d3e-9a5c-7b9d1f3e5a7c",label="my-other-vfs",region="ewr",status="pending"} 0
+	# HELP test_virtual_file_system_size Size (GB) of Virtual File System
+	# TYPE test_virtual_file_system_size gauge
+	test_virtual_file_system_size{disk_type="nvme",id="5b1d3f7a-2c4e-4b6d-8f0a-1c3e5a7b9d2f",label="my-vfs",region="ewr",status="active"} 100
+	test_virtual_file_system_size{disk_type="nvme",id="8e0a2c4d-6f1b-4d3e-9a5c-7b9d1f3e5a7c",label="my-other-vfs",region="ewr",status="pending"} 50
+	# HELP test_virtual_file_system_up Virtual File System
+	# TYPE test_virtual_file_system_up counter
+	test_virtual_file_system_up{disk_type="nvme",id="5b1d3f7a-2c4e-4b6d-8f0a-1c3e5a7b9d2f",label="my-vfs",region="ewr",status="active"} 1
+	test_virtual_file_system_up{disk_type="nvme",id="8e0a2c4d-6f1b-4d3e-9a5c-7b9d1f3e5a7c",label="my-other-vfs",region="ewr",status="pending"} 0
+	# HELP test_virtual_file_system_used_bytes Storage (bytes) used by Virtual File System
+	# TYPE test_virtual_file_system_used_bytes gauge
+	test_virtual_file_system_used_bytes{disk_type="nvme",id="5b1d3f7a-2c4e-4b6d-8f0a-1c3e5a7b9d2f",label="my-vfs",region="ewr",status="active"} 1.048576e+06
+	test_virtual_file_system_used_bytes{disk_type="nvme",id="8e0a2c4d-6f1b-4d3e-9a5c-7b9d1f3e5a7c",label="my-other-vfs",region="ewr",status="pending"} 0
+	`
+)
+
+func TestVirtualFileSystemCollector(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for path, response := range map[string]string{
+		"/v2/vfs": responseVirtualFileSystems,
+		"/v2/vfs/5b1d3f7a-2c4e-4b6d-8f0a-1c3e5a7b9d2f/attachments": responseVirtualFileSystemAttachments,
+		"/v2/vfs/8e0a2c4d-6f1b-4d3e-9a5c-7b9d1f3e5a7c/attachments": responseVirtualFileSystemAttachmentsNone,
+	} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if _, err := fmt.Fprint(w, response); err != nil {
+				t.Errorf("unable to write response: %v", err)
+			}
+		})
+	}
+
+	log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+	log = log.WithName("test")
+
+	s := System{
+		Namespace: tNamespace,
+		Subsystem: tSubsystem,
+		Version:   tVersion,
+	}
+
+	collector := NewVirtualFileSystemCollector(s, client, log)
+
+	if err := testutil.CollectAndCompare(
+		collector,
+		strings.NewReader(prometheusVirtualFileSystem),
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
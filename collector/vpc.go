@@ -0,0 +1,337 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+const (
+	// vpcInfoConcurrency is the maximum number of concurrent queries for Instances' and Bare Metal servers' VPCs
+	vpcInfoConcurrency = 10
+)
+
+// VPCCollector represents VPCs
+type VPCCollector struct {
+	System    System
+	Client    *govultr.Client
+	Log       logr.Logger
+	Up        *prometheus.Desc
+	Nodes     *prometheus.Desc
+	Addresses *prometheus.Desc
+}
+
+// NewVPCCollector creates a new VPCCollector
+func NewVPCCollector(s System, client *govultr.Client, log logr.Logger) *VPCCollector {
+	subsystem := "vpc"
+	labelKeys := []string{
+		"id",
+		"description",
+		"region",
+		"subnet",
+		"subnet_mask",
+	}
+	return &VPCCollector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Up: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "up"),
+			"VPC",
+			labelKeys,
+			nil,
+		),
+		Nodes: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "nodes"),
+			"Number of Instances, Bare Metal servers, Managed Databases and Load Balancers attached to the VPC",
+			labelKeys,
+			nil,
+		),
+		Addresses: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "addresses"),
+			"Number of addresses in the VPC's subnet",
+			labelKeys,
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *VPCCollector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx := context.Background()
+
+	// Get all VPCs across all pages
+	var allVPCs []govultr.VPC
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		vpcs, meta, _, err := c.Client.VPC.List(ctx, options)
+		if err != nil {
+			log.Error(err, "Unable to VPC.List")
+			return
+		}
+
+		allVPCs = append(allVPCs, vpcs...)
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	// VPCs do not enumerate their nodes
+	// Instead, each Instance and Bare Metal server enumerates the VPCs to which it is attached
+	// If the nodes can't be counted, the Nodes metric is omitted rather than reported as zero
+	nodes, err := c.countNodes(ctx)
+	if err != nil {
+		log.Error(err, "Unable to count VPC nodes")
+	}
+
+	for _, vpc := range allVPCs {
+		labelValues := []string{
+			vpc.ID,
+			vpc.Description,
+			vpc.Region,
+			vpc.V4Subnet,
+			strconv.Itoa(vpc.V4SubnetMask),
+		}
+		ch <- prometheus.MustNewConstMetric(
+			c.Up,
+			prometheus.CounterValue,
+			1.0,
+			labelValues...,
+		)
+		if nodes != nil {
+			ch <- prometheus.MustNewConstMetric(
+				c.Nodes,
+				prometheus.GaugeValue,
+				float64(nodes[vpc.ID]),
+				labelValues...,
+			)
+		}
+		ch <- prometheus.MustNewConstMetric(
+			c.Addresses,
+			prometheus.GaugeValue,
+			subnetSize(vpc.V4SubnetMask),
+			labelValues...,
+		)
+	}
+}
+
+// countNodes returns the number of Instances, Bare Metal servers, Managed Databases and Load Balancers attached to each VPC (by ID)
+// Kubernetes clusters are not counted because govultr does not expose their VPC
+// If any of these can't be listed, an error is returned rather than a partial count
+func (c *VPCCollector) countNodes(ctx context.Context) (map[string]int, error) {
+	var mu sync.Mutex
+	nodes := make(map[string]int)
+	add := func(ids ...string) {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, id := range ids {
+			if id != "" {
+				nodes[id]++
+			}
+		}
+	}
+
+	// Instances and Bare Metal servers are queried for their VPCs individually
+	// The number of concurrent queries is bounded to avoid Vultr's API rate limit
+	sem := make(chan struct{}, vpcInfoConcurrency)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 4)
+	for _, count := range []func() error{
+		func() error { return c.countInstances(ctx, sem, add) },
+		func() error { return c.countBareMetalServers(ctx, sem, add) },
+		func() error { return c.countDatabases(ctx, add) },
+		func() error { return c.countLoadBalancers(ctx, add) },
+	} {
+		wg.Add(1)
+		go func(count func() error) {
+			defer wg.Done()
+			if err := count(); err != nil {
+				errs <- err
+			}
+		}(count)
+	}
+	wg.Wait()
+	close(errs)
+
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+// countInstances counts the VPCs to which each Instance is attached
+func (c *VPCCollector) countInstances(ctx context.Context, sem chan struct{}, add func(...string)) error {
+	var allInstances []govultr.Instance
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		instances, meta, _, err := c.Client.Instance.List(ctx, options)
+		if err != nil {
+			return fmt.Errorf("unable to Instance.List: %w", err)
+		}
+
+		allInstances = append(allInstances, instances...)
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(allInstances))
+	for _, instance := range allInstances {
+		wg.Add(1)
+		go func(instance govultr.Instance) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			vpcs, _, _, err := c.Client.Instance.ListVPCInfo(ctx, instance.ID, &govultr.ListOptions{
+				PerPage: 100,
+			})
+			if err != nil {
+				errs <- fmt.Errorf("unable to Instance.ListVPCInfo (%s): %w", instance.ID, err)
+				return
+			}
+			for _, vpc := range vpcs {
+				add(vpc.ID)
+			}
+		}(instance)
+	}
+	wg.Wait()
+	close(errs)
+
+	return <-errs
+}
+
+// countBareMetalServers counts the VPCs to which each Bare Metal server is attached
+func (c *VPCCollector) countBareMetalServers(ctx context.Context, sem chan struct{}, add func(...string)) error {
+	var allServers []govultr.BareMetalServer
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		servers, meta, _, err := c.Client.BareMetalServer.List(ctx, options)
+		if err != nil {
+			return fmt.Errorf("unable to BareMetalServer.List: %w", err)
+		}
+
+		allServers = append(allServers, servers...)
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(allServers))
+	for _, server := range allServers {
+		wg.Add(1)
+		go func(server govultr.BareMetalServer) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			vpcs, _, err := c.Client.BareMetalServer.ListVPCInfo(ctx, server.ID)
+			if err != nil {
+				errs <- fmt.Errorf("unable to BareMetalServer.ListVPCInfo (%s): %w", server.ID, err)
+				return
+			}
+			for _, vpc := range vpcs {
+				add(vpc.ID)
+			}
+		}(server)
+	}
+	wg.Wait()
+	close(errs)
+
+	return <-errs
+}
+
+// countDatabases counts the VPC to which each Managed Database is attached
+func (c *VPCCollector) countDatabases(ctx context.Context, add func(...string)) error {
+	// DBListOptions does not support cursors
+	// The Database service returns all Managed Databases in a single page
+	databases, _, _, err := c.Client.Database.List(ctx, &govultr.DBListOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to Database.List: %w", err)
+	}
+
+	for _, database := range databases {
+		add(database.VPCID)
+	}
+	return nil
+}
+
+// countLoadBalancers counts the VPC to which each Load Balancer is attached
+func (c *VPCCollector) countLoadBalancers(ctx context.Context, add func(...string)) error {
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		loadbalancers, meta, _, err := c.Client.LoadBalancer.List(ctx, options)
+		if err != nil {
+			return fmt.Errorf("unable to LoadBalancer.List: %w", err)
+		}
+
+		for _, lb := range loadbalancers {
+			if lb.GenericInfo != nil {
+				add(lb.GenericInfo.VPC)
+			}
+		}
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+	return nil
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *VPCCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Up
+	ch <- c.Nodes
+	ch <- c.Addresses
+}
+
+// subnetSize returns the number of IPv4 addresses in a subnet with the given mask (prefix length)
+func subnetSize(mask int) float64 {
+	if mask < 0 || mask > 32 {
+		return 0
+	}
+	return math.Pow(2, float64(32-mask))
+}
@@ -0,0 +1,158 @@
+package collector
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vultr/govultr/v3"
+)
+
+// VPC2Collector represents VPC 2.0 networks
+// VPC 2.0 is deprecated by govultr but networks remain in use
+type VPC2Collector struct {
+	System    System
+	Client    *govultr.Client
+	Log       logr.Logger
+	Up        *prometheus.Desc
+	Nodes     *prometheus.Desc
+	Addresses *prometheus.Desc
+}
+
+// NewVPC2Collector creates a new VPC2Collector
+func NewVPC2Collector(s System, client *govultr.Client, log logr.Logger) *VPC2Collector {
+	subsystem := "vpc2"
+	labelKeys := []string{
+		"id",
+		"description",
+		"region",
+		"subnet",
+		"subnet_mask",
+	}
+	return &VPC2Collector{
+		System: s,
+		Client: client,
+		Log:    log,
+		Up: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "up"),
+			"VPC 2.0 network",
+			labelKeys,
+			nil,
+		),
+		Nodes: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "nodes"),
+			"Number of nodes attached to the VPC 2.0 network",
+			labelKeys,
+			nil,
+		),
+		Addresses: prometheus.NewDesc(
+			prometheus.BuildFQName(s.Namespace, subsystem, "addresses"),
+			"Number of addresses in the VPC 2.0 network's IP block",
+			labelKeys,
+			nil,
+		),
+	}
+}
+
+// Collect implements Prometheus' Collector interface and is used to collect metrics
+func (c *VPC2Collector) Collect(ch chan<- prometheus.Metric) {
+	log := c.Log.WithName("Collect")
+	ctx := context.Background()
+
+	// Get all VPC 2.0 networks across all pages
+	var allVPCs []govultr.VPC2
+	options := &govultr.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		vpcs, meta, _, err := c.Client.VPC2.List(ctx, options) //nolint:staticcheck // VPC 2.0 is deprecated
+		if err != nil {
+			log.Error(err, "Unable to VPC2.List")
+			return
+		}
+
+		allVPCs = append(allVPCs, vpcs...)
+
+		// If we've received all items or there's no next page, break
+		if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+			break
+		}
+
+		// Move to next page
+		options.Cursor = meta.Links.Next
+	}
+
+	// Enumerate the networks
+	var wg sync.WaitGroup
+	for _, vpc := range allVPCs {
+		wg.Add(1)
+		go func(vpc govultr.VPC2) {
+			defer wg.Done()
+
+			labelValues := []string{
+				vpc.ID,
+				vpc.Description,
+				vpc.Region,
+				vpc.IPBlock,
+				strconv.Itoa(vpc.PrefixLength),
+			}
+
+			ch <- prometheus.MustNewConstMetric(
+				c.Up,
+				prometheus.CounterValue,
+				1.0,
+				labelValues...,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.Addresses,
+				prometheus.GaugeValue,
+				subnetSize(vpc.PrefixLength),
+				labelValues...,
+			)
+
+			// Get all of the network's nodes across all pages
+			var allNodes []govultr.VPC2Node
+			options := &govultr.ListOptions{
+				PerPage: 100,
+			}
+
+			for {
+				nodes, meta, _, err := c.Client.VPC2.ListNodes(ctx, vpc.ID, options) //nolint:staticcheck // VPC 2.0 is deprecated
+				if err != nil {
+					log.Error(err, "Unable to VPC2.ListNodes",
+						"id", vpc.ID,
+					)
+					return
+				}
+
+				allNodes = append(allNodes, nodes...)
+
+				// If we've received all items or there's no next page, break
+				if meta != nil && meta.Links != nil && meta.Links.Next == "" {
+					break
+				}
+
+				// Move to next page
+				options.Cursor = meta.Links.Next
+			}
+
+			ch <- prometheus.MustNewConstMetric(
+				c.Nodes,
+				prometheus.GaugeValue,
+				float64(len(allNodes)),
+				labelValues...,
+			)
+		}(vpc)
+	}
+	wg.Wait()
+}
+
+// Describe implements Prometheus' Collector interface and is used to describe metrics
+func (c *VPC2Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.Up
+	ch <- c.Nodes
+	ch <- c.Addresses
+}
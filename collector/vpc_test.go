@@ -0,0 +1,230 @@
+package collector
+
+import (
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/stdr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	responseVPCs string = `{
+		"vpcs": [
+			{
+				"id": "7ff1b5e3-9d4a-4e2b-8f6c-1a2b3c4d5e6f",
+				"region": "ewr",
+				"description": "instances",
+				"v4_subnet": "10.0.0.0",
+				"v4_subnet_mask": 24
+			},
+			{
+				"id": "2c8e4a6b-1d3f-4b5a-9c7e-6f5e4d3c2b1a",
+				"region": "ewr",
+				"description": "database",
+				"v4_subnet": "10.1.0.0",
+				"v4_subnet_mask": 24
+			},
+			{
+				"id": "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+				"region": "ewr",
+				"description": "empty",
+				"v4_subnet": "10.2.0.0",
+				"v4_subnet_mask": 24
+			}
+		],
+		"meta": {
+			"total": 3,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseVPCInstances string = `{
+		"instances": [
+			{
+				"id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60"
+			}
+		],
+		"meta": {
+			"total": 1,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseVPCInstanceVPCs string = `{
+		"vpcs": [
+			{
+				"id": "7ff1b5e3-9d4a-4e2b-8f6c-1a2b3c4d5e6f",
+				"mac_address": "5a:01:04:3d:5e:72",
+				"ip_address": "10.0.0.3"
+			}
+		],
+		"meta": {
+			"total": 1,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseVPCBareMetals string = `{
+		"bare_metals": [
+			{
+				"id": "fb2b2c0d-0a1b-4c2d-8e3f-4a5b6c7d8e9f"
+			}
+		],
+		"meta": {
+			"total": 1,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseVPCBareMetalVPCs string = `{
+		"vpcs": [
+			{
+				"id": "7ff1b5e3-9d4a-4e2b-8f6c-1a2b3c4d5e6f",
+				"mac_address": "5a:01:04:3d:5e:73",
+				"ip_address": "10.0.0.4"
+			}
+		]
+	}`
+	responseVPCDatabases string = `{
+		"databases": [
+			{
+				"id": "4f6e8d2c-1b3a-4c5d-9e7f-8a9b0c1d2e3f",
+				"vpc_id": "2c8e4a6b-1d3f-4b5a-9c7e-6f5e4d3c2b1a"
+			}
+		],
+		"meta": {
+			"total": 1,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	responseVPCLoadBalancers string = `{
+		"load_balancers": [
+			{
+				"id": "1317575f-e8a3-4bfb-9d2e-4aaf2f3d8f7c",
+				"generic_info": {
+					"vpc": "7ff1b5e3-9d4a-4e2b-8f6c-1a2b3c4d5e6f"
+				}
+			}
+		],
+		"meta": {
+			"total": 1,
+			"links": {
+				"next": "",
+				"prev": ""
+			}
+		}
+	}`
+	prometheusVPCAddresses string = `
+	# HELP test_vpc_addresses Number of addresses in the VPC's subnet
+	# TYPE test_vpc_addresses gauge
+	test_vpc_addresses{description="database",id="2c8e4a6b-1d3f-4b5a-9c7e-6f5e4d3c2b1a",region="ewr",subnet="10.1.0.0",subnet_mask="24"} 256
+	test_vpc_addresses{description="empty",id="9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",region="ewr",subnet="10.2.0.0",subnet_mask="24"} 256
+	test_vpc_addresses{description="instances",id="7ff1b5e3-9d4a-4e2b-8f6c-1a2b3c4d5e6f",region="ewr",subnet="10.0.0.0",subnet_mask="24"} 256
+	`
+	prometheusVPCNodes string = `
+	# HELP test_vpc_nodes Number of Instances, Bare Metal servers, Managed Databases and Load Balancers attached to the VPC
+	# TYPE test_vpc_nodes gauge
+	test_vpc_nodes{description="database",id="2c8e4a6b-1d3f-4b5a-9c7e-6f5e4d3c2b1a",region="ewr",subnet="10.1.0.0",subnet_mask="24"} 1
+	test_vpc_nodes{description="empty",id="9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",region="ewr",subnet="10.2.0.0",subnet_mask="24"} 0
+	test_vpc_nodes{description="instances",id="7ff1b5e3-9d4a-4e2b-8f6c-1a2b3c4d5e6f",region="ewr",subnet="10.0.0.0",subnet_mask="24"} 3
+	`
+)
+
+func TestVPCCollector(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		status int
+		want   string
+	}{
+		{
+			name:   "Nodes",
+			status: http.StatusOK,
+			want:   prometheusVPCAddresses + prometheusVPCNodes,
+		},
+		{
+			// If the nodes can't be counted, vpc_nodes is omitted rather than reported as zero
+			name:   "Error",
+			status: http.StatusBadRequest,
+			want:   prometheusVPCAddresses,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			for path, response := range map[string]string{
+				"/v2/vpcs": responseVPCs,
+				"/v2/instances/cb676a46-66fd-4dfb-b839-443f2e6c0b60/vpcs": responseVPCInstanceVPCs,
+				"/v2/bare-metals": responseVPCBareMetals,
+				"/v2/bare-metals/fb2b2c0d-0a1b-4c2d-8e3f-4a5b6c7d8e9f/vpcs": responseVPCBareMetalVPCs,
+				"/v2/databases":      responseVPCDatabases,
+				"/v2/load-balancers": responseVPCLoadBalancers,
+			} {
+				mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+					if _, err := fmt.Fprint(w, response); err != nil {
+						t.Errorf("unable to write response: %v", err)
+					}
+				})
+			}
+			mux.HandleFunc("/v2/instances", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+				if _, err := fmt.Fprint(w, responseVPCInstances); err != nil {
+					t.Errorf("unable to write response: %v", err)
+				}
+			})
+
+			log := stdr.NewWithOptions(stdlog.New(os.Stderr, "", stdlog.LstdFlags), stdr.Options{LogCaller: stdr.All})
+			log = log.WithName("test")
+
+			s := System{
+				Namespace: tNamespace,
+				Subsystem: tSubsystem,
+				Version:   tVersion,
+			}
+
+			collector := NewVPCCollector(s, client, log)
+
+			if err := testutil.CollectAndCompare(
+				collector,
+				strings.NewReader(test.want),
+				"test_vpc_addresses",
+				"test_vpc_nodes",
+			); err != nil {
+				t.Errorf("unexpected collecting result:\n%s", err)
+			}
+		})
+	}
+}
+
+func TestSubnetSize(t *testing.T) {
+	for mask, want := range map[int]float64{
+		32: 1,
+		24: 256,
+		20: 4096,
+		16: 65536,
+		33: 0,
+		-1: 0,
+	} {
+		t.Run(fmt.Sprintf("/%d", mask), func(t *testing.T) {
+			if got := subnetSize(mask); got != want {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}